@@ -0,0 +1,9 @@
+// Package api carries the checked-in API contracts so they can be embedded in the binary.
+package api
+
+import _ "embed"
+
+// OpenAPISpec is the OpenAPI 3 document describing the HTTP API.
+//
+//go:embed openapi.yaml
+var OpenAPISpec []byte
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/config"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/database"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/telemetry"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/transaction"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/repositories/apikey"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/repositories/cause"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/repositories/installment"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/repositories/product"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/repositories/settings"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/repositories/sharing"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/repositories/tag"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/repositories/wishlist"
+	. "github.com/zhunismp/intent-products-api/internal/core/domain/apikey"
+	. "github.com/zhunismp/intent-products-api/internal/core/domain/cause"
+	. "github.com/zhunismp/intent-products-api/internal/core/domain/installment"
+	. "github.com/zhunismp/intent-products-api/internal/core/domain/product"
+	. "github.com/zhunismp/intent-products-api/internal/core/domain/search"
+	. "github.com/zhunismp/intent-products-api/internal/core/domain/settings"
+	. "github.com/zhunismp/intent-products-api/internal/core/domain/sharing"
+	. "github.com/zhunismp/intent-products-api/internal/core/domain/tag"
+	. "github.com/zhunismp/intent-products-api/internal/core/domain/wishlist"
+	"gorm.io/gorm"
+)
+
+// services are the use cases shared by the server and the admin commands, so both go
+// through the same rules
+type services struct {
+	product     ProductUsecase
+	installment InstallmentUsecase
+	apiKey      ApiKeyUsecase
+	search      SearchUsecase
+	wishlist    WishlistUsecase
+	sharing     SharingUsecase
+	tag         TagUsecase
+	settings    SettingsUsecase
+}
+
+func newServices(cfg *AppEnvConfig, db *gorm.DB, logger *slog.Logger) *services {
+	txManager := NewGormTxManager(db)
+
+	productDbRepo := NewProductRepository(db)
+	statusHistoryDbRepo := NewStatusHistoryRepository(db)
+	causeDbRepo := NewCauseRepository(db)
+	installmentDbRepo := NewInstallmentRepository(db)
+	apiKeyDbRepo := NewApiKeyRepository(db)
+	searchDbRepo := NewSearchRepository(db)
+	wishlistDbRepo := NewWishlistRepository(db)
+	sharingDbRepo := NewSharingRepository(db)
+	tagDbRepo := NewTagRepository(db)
+	settingsDbRepo := NewSettingsRepository(db)
+
+	causeSvc := NewCauseService(causeDbRepo, logger)
+	tagSvc := NewTagService(tagDbRepo, txManager, logger)
+	wishlistSvc := NewWishlistService(wishlistDbRepo, txManager, logger)
+	sharingSvc := NewSharingService(sharingDbRepo, txManager, logger)
+	settingsSvc := NewSettingsService(settingsDbRepo, txManager, logger)
+	productSvc := NewProductService(productDbRepo, statusHistoryDbRepo, causeSvc, tagSvc, wishlistSvc, settingsSvc, installmentDbRepo, sharingSvc, txManager, cfg.GetPositionMaxKeyLength(), logger)
+	installmentSvc := NewInstallmentService(installmentDbRepo, productSvc, sharingSvc, txManager, logger)
+	apiKeySvc := NewApiKeyService(apiKeyDbRepo, logger)
+	searchSvc := NewSearchService(searchDbRepo, logger)
+
+	return &services{
+		product:     productSvc,
+		installment: installmentSvc,
+		apiKey:      apiKeySvc,
+		search:      searchSvc,
+		wishlist:    wishlistSvc,
+		sharing:     sharingSvc,
+		tag:         tagSvc,
+		settings:    settingsSvc,
+	}
+}
+
+func connectDatabase(cfg *AppEnvConfig) (*gorm.DB, func(ctx context.Context) error, error) {
+	return NewPostgresDatabase(
+		cfg.GetDBHost(),
+		cfg.GetDBUser(),
+		cfg.GetDBPassword(),
+		cfg.GetDBName(),
+		cfg.GetDBPort(),
+		cfg.GetDBSSLMode(),
+		cfg.GetDBTimezone(),
+	)
+}
+
+// commandEnv is what an admin command runs against, the configuration, database and
+// services of the server with a logger writing to stderr
+type commandEnv struct {
+	cfg     *AppEnvConfig
+	logger  *slog.Logger
+	db      *gorm.DB
+	svc     *services
+	closeDB func(ctx context.Context) error
+}
+
+// setupCommand connects to the database, unless checkSchema is false it also refuses a
+// schema that is behind like the server does.
+func setupCommand(checkSchema bool) (*commandEnv, error) {
+	cfg, err := LoadConfig(".env")
+	if err != nil {
+		return nil, err
+	}
+	logger := NewCommandLogger()
+
+	db, closeDB, err := connectDatabase(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if checkSchema {
+		migrator, err := NewMigrator(db, logger)
+		if err != nil {
+			closeDB(context.Background())
+			return nil, err
+		}
+		if err := migrator.CheckCurrent(context.Background()); err != nil {
+			closeDB(context.Background())
+			return nil, fmt.Errorf("%w, run `app migrate up` first", err)
+		}
+	}
+
+	return &commandEnv{
+		cfg:     cfg,
+		logger:  logger,
+		db:      db,
+		svc:     newServices(cfg, db, logger),
+		closeDB: closeDB,
+	}, nil
+}
+
+func (e *commandEnv) close() {
+	if err := e.closeDB(context.Background()); err != nil {
+		e.logger.Error("failed to close the database", slog.Any("error", err))
+	}
+}
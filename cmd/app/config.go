@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/config"
+)
+
+const configUsage = `usage: app config <command>
+
+commands:
+  print  print the effective configuration with secrets redacted`
+
+func runConfig(args []string) int {
+	if len(args) == 0 || args[0] != "print" {
+		fmt.Fprintln(os.Stderr, configUsage)
+		return 2
+	}
+
+	cfg, err := LoadConfig(".env")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, entry := range cfg.RedactedEntries() {
+		fmt.Fprintf(w, "%s\t%s\n", entry.Key, entry.Value)
+	}
+	w.Flush()
+
+	return 0
+}
@@ -1,87 +1,50 @@
 package main
 
 import (
-	"context"
+	"fmt"
 	"os"
-	"os/signal"
-	"syscall"
-	"time"
-
-	. "github.com/zhunismp/intent-products-api/internal/adapters/primary/http"
-	. "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/product"
-	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/config"
-	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/database"
-	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/shutdown"
-	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/telemetry"
-	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/repositories/cause"
-	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/repositories/product"
-	. "github.com/zhunismp/intent-products-api/internal/core/domain/cause"
-	. "github.com/zhunismp/intent-products-api/internal/core/domain/product"
 )
 
-func main() {
-	cfg, err := LoadConfig(".env")
-	if err != nil {
-		panic(err.Error())
-	}
-	logger := GetLogger(cfg.GetServerEnv(), cfg.GetServerName())
-	sm := NewShutdownManager(20*time.Second, logger)
-
-	otelShutdownFn, err := SetupTelemetry(context.Background(), cfg.GetServerName(), cfg.GetServerEnv())
-	if err != nil {
-		sm.Shutdown()
-	}
-	sm.Register(&ShutdownFunction{
-		ResourceName: "opentelemetry",
-		Fn:           otelShutdownFn,
-	})
-
-	db, dbShutdownFn, err := NewPostgresDatabase(
-		cfg.GetDBHost(),
-		cfg.GetDBUser(),
-		cfg.GetDBPassword(),
-		cfg.GetDBName(),
-		cfg.GetDBPort(),
-		cfg.GetDBSSLMode(),
-		cfg.GetDBTimezone(),
-	)
-	if err != nil {
-		sm.Shutdown()
-	}
-	sm.Register(&ShutdownFunction{
-		ResourceName: "database",
-		Fn:           dbShutdownFn,
-	})
-
-	baseApiPrefix := cfg.GetServerBaseApiPrefix()
+const usage = `usage: app [command]
 
-	productDbRepo := NewProductRepository(db)
-	causeDbRepo := NewCauseRepository(db)
+commands:
+  serve                 start the HTTP, gRPC and gateway servers (default)
+  migrate               apply, revert or inspect database migrations
+  seed                  create demo products for an owner
+  export                write an owner's wishlist as JSON
+  import                append a wishlist exported as JSON to an owner
+  rebalance-positions   spread an owner's position keys evenly again
+  config print          print the effective configuration with secrets redacted
 
-	causeSvc := NewCauseService(causeDbRepo, logger)
-	productSvc := NewProductService(productDbRepo, causeSvc, logger)
+run "app <command> -h" for the flags of a command`
 
-	// HTTP
-	productHttp := NewProductHttpHandler(productSvc, logger)
-	routeGroup := NewRouteGroup(productHttp)
-	httpServer := NewHttpServer(cfg, logger, baseApiPrefix)
-	httpServer.SetupRoute(routeGroup)
-	httpServer.Start()
-	sm.Register(&ShutdownFunction{
-		ResourceName: "http server",
-		Fn:           httpServer.GracefulShutdown,
-	})
-
-	gracefulShutdown(sm)
-}
-
-func gracefulShutdown(sm ShutdownManager) {
-	quit := make(chan os.Signal, 1)
-
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	defer signal.Stop(quit)
-
-	<-quit
+func main() {
+	// no command keeps the image entrypoint starting the server
+	if len(os.Args) < 2 {
+		serve()
+		return
+	}
 
-	sm.Shutdown()
+	command, args := os.Args[1], os.Args[2:]
+	switch command {
+	case "serve":
+		serve()
+	case "migrate":
+		os.Exit(runMigrate(args))
+	case "seed":
+		os.Exit(runSeed(args))
+	case "export":
+		os.Exit(runExport(args))
+	case "import":
+		os.Exit(runImport(args))
+	case "rebalance-positions":
+		os.Exit(runRebalancePositions(args))
+	case "config":
+		os.Exit(runConfig(args))
+	case "help", "-h", "--help":
+		fmt.Println(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s\n", command, usage)
+		os.Exit(2)
+	}
 }
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/database"
+)
+
+const migrateUsage = `usage: app migrate <command>
+
+commands:
+  up               apply every pending migration
+  down [steps]     revert the last applied migrations, one by default
+  status           list migrations and whether they are applied
+  force <version>  record the schema at version and clear the dirty flag, 0 clears everything`
+
+// runMigrate runs a migrate command and returns the exit code of the process
+func runMigrate(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	env, err := setupCommand(false)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer env.close()
+
+	migrator, err := NewMigrator(env.db, env.logger)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Printf("applied %d migration(s), schema is at version %d\n", applied, migrator.LatestVersion())
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				fmt.Fprintln(os.Stderr, "steps must be a positive number")
+				return 2
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Printf("reverted %d migration(s)\n", reverted)
+
+	case "status":
+		states, err := migrator.Status(ctx)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		printMigrationStatus(states)
+
+	case "force":
+		if len(args) < 2 {
+			fmt.Fprintln(os.Stderr, migrateUsage)
+			return 2
+		}
+		version, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "version must be a number")
+			return 2
+		}
+		if err := migrator.Force(ctx, uint(version)); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Printf("schema forced to version %d\n", version)
+
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	return 0
+}
+
+func printMigrationStatus(states []*MigrationState) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATE\tAPPLIED AT")
+
+	for _, state := range states {
+		status := "pending"
+		switch {
+		case state.Dirty:
+			status = "dirty"
+		case state.Unknown:
+			status = "applied (newer release)"
+		case state.Applied:
+			status = "applied"
+		}
+
+		appliedAt := ""
+		if state.AppliedAt != nil {
+			appliedAt = state.AppliedAt.Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", state.Version, state.Name, status, appliedAt)
+	}
+
+	w.Flush()
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+)
+
+func runRebalancePositions(args []string) int {
+	flags := flag.NewFlagSet("rebalance-positions", flag.ContinueOnError)
+	ownerID := flags.Uint("owner", 0, "id of the owner whose positions are rebalanced (required)")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *ownerID == 0 {
+		fmt.Fprintln(os.Stderr, "-owner is required")
+		flags.Usage()
+		return 2
+	}
+
+	env, err := setupCommand(true)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer env.close()
+
+	moved, err := env.svc.product.RebalancePositions(context.Background(), *ownerID)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	fmt.Printf("rebalanced owner %d, %d product(s) got a new position key\n", *ownerID, moved)
+	return 0
+}
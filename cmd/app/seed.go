@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+)
+
+type demoProduct struct {
+	name    string
+	price   float64
+	link    string
+	reasons []string
+}
+
+var demoProducts = []demoProduct{
+	{"Noise cancelling headphones", 349, "https://example.com/headphones", []string{"the open office is loud", "long flights next year"}},
+	{"Mechanical keyboard", 129, "https://example.com/keyboard", []string{"typing all day", "current keyboard is missing keys"}},
+	{"Standing desk", 499, "https://example.com/desk", []string{"back pain after long days"}},
+	{"Espresso machine", 699, "https://example.com/espresso", []string{"spending too much at cafes"}},
+	{"Running shoes", 140, "https://example.com/shoes", []string{"training for a half marathon", "old pair is worn out"}},
+	{"E-reader", 159, "https://example.com/ereader", []string{"read more before bed"}},
+	{"Cast iron pan", 45, "https://example.com/pan", []string{"cook at home more often"}},
+	{"Camping tent", 259, "https://example.com/tent", []string{"weekend trips with friends"}},
+}
+
+func runSeed(args []string) int {
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	ownerID := flags.Uint("owner", 0, "id of the owner to create the products for (required)")
+	count := flags.Int("count", len(demoProducts), "number of demo products to create")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *ownerID == 0 || *count < 1 || *count > len(demoProducts) {
+		fmt.Fprintf(os.Stderr, "-owner is required and -count must be between 1 and %d\n", len(demoProducts))
+		flags.Usage()
+		return 2
+	}
+
+	env, err := setupCommand(true)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer env.close()
+
+	ctx := context.Background()
+	for _, demo := range demoProducts[:*count] {
+		if err := env.svc.product.CreateProduct(ctx, *ownerID, 0, demo.name, demo.price, demo.link, demo.reasons, nil); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+	}
+
+	fmt.Printf("created %d demo product(s) for owner %d\n", *count, *ownerID)
+	return 0
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	. "github.com/zhunismp/intent-products-api/internal/adapters/primary/gateway"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/primary/grpc"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/primary/grpc/product"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/primary/http"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/apikey"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/installment"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/product"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/search"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/settings"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/sharing"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/tag"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/wishlist"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/config"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/database"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/shutdown"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/telemetry"
+	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/token"
+)
+
+func serve() {
+	cfg, err := LoadConfig(".env")
+	if err != nil {
+		panic(err.Error())
+	}
+	logger := GetLogger(cfg.GetServerEnv(), cfg.GetServerName())
+	sm := NewShutdownManager(20*time.Second, logger)
+
+	otelShutdownFn, err := SetupTelemetry(context.Background(), cfg.GetServerName(), cfg.GetServerEnv())
+	if err != nil {
+		abortStart(sm, logger, "failed to set up telemetry", err)
+	}
+	sm.Register(&ShutdownFunction{
+		ResourceName: "opentelemetry",
+		Fn:           otelShutdownFn,
+	})
+
+	db, dbShutdownFn, err := connectDatabase(cfg)
+	if err != nil {
+		abortStart(sm, logger, "failed to connect to the database", err)
+	}
+	sm.Register(&ShutdownFunction{
+		ResourceName: "database",
+		Fn:           dbShutdownFn,
+	})
+
+	// the schema is owned by the migrate command, a replica never changes it on boot
+	migrator, err := NewMigrator(db, logger)
+	if err != nil {
+		abortStart(sm, logger, "failed to load migrations", err)
+	}
+	if err := migrator.CheckCurrent(context.Background()); err != nil {
+		abortStart(sm, logger, "refusing to start, run `app migrate up` first", err)
+	}
+
+	baseApiPrefix := cfg.GetServerBaseApiPrefix()
+
+	tokenVerifier, err := NewJwtVerifier(
+		context.Background(),
+		cfg.GetAuthJwksFile(),
+		cfg.GetAuthJwksURL(),
+		cfg.GetAuthIssuer(),
+		cfg.GetAuthAudience(),
+	)
+	if err != nil {
+		abortStart(sm, logger, "failed to set up the token verifier", err)
+	}
+
+	svc := newServices(cfg, db, logger)
+
+	// HTTP
+	productHttp := NewProductHttpHandler(svc.product, logger)
+	installmentHttp := NewInstallmentHttpHandler(svc.installment, logger)
+	apiKeyHttp := NewApiKeyHttpHandler(svc.apiKey, logger)
+	searchHttp := NewSearchHttpHandler(svc.search, logger)
+	wishlistHttp := NewWishlistHttpHandler(svc.wishlist, logger)
+	sharingHttp := NewSharingHttpHandler(svc.sharing, logger)
+	tagHttp := NewTagHttpHandler(svc.tag, logger)
+	settingsHttp := NewSettingsHttpHandler(svc.settings, logger)
+	routeGroup := NewRouteGroup(productHttp, installmentHttp, apiKeyHttp, searchHttp, wishlistHttp, sharingHttp, tagHttp, settingsHttp)
+	httpServer := NewHttpServer(cfg, logger, baseApiPrefix, tokenVerifier, svc.apiKey)
+	httpServer.SetupRoute(routeGroup)
+	httpServer.Start()
+	sm.Register(&ShutdownFunction{
+		ResourceName: "http server",
+		Fn:           httpServer.GracefulShutdown,
+	})
+
+	// gRPC
+	productGrpc := NewProductGrpcHandler(svc.product, logger)
+	serviceGroup := NewServiceGroup(productGrpc)
+	grpcServer := NewGrpcServer(cfg, logger, tokenVerifier, svc.apiKey)
+	if err := grpcServer.RegisterServices(serviceGroup); err != nil {
+		abortStart(sm, logger, "failed to register the gRPC services", err)
+	}
+	grpcServer.Start()
+	sm.Register(&ShutdownFunction{
+		ResourceName: "grpc server",
+		Fn:           grpcServer.GracefulShutdown,
+	})
+
+	// REST gateway generated from the gRPC definitions
+	if cfg.GetGatewayEnabled() {
+		gatewayServer, err := NewGatewayServer(cfg, logger)
+		if err != nil {
+			abortStart(sm, logger, "failed to start the rest gateway", err)
+		}
+		gatewayServer.Start()
+		sm.Register(&ShutdownFunction{
+			ResourceName: "rest gateway",
+			Fn:           gatewayServer.GracefulShutdown,
+		})
+	}
+
+	gracefulShutdown(sm)
+}
+
+// abortStart reports why the server can not start, releases what was already set up and
+// exits non-zero.
+func abortStart(sm ShutdownManager, logger *slog.Logger, msg string, err error) {
+	logger.Error(msg, slog.Any("error", err))
+	sm.Abort()
+}
+
+func gracefulShutdown(sm ShutdownManager) {
+	quit := make(chan os.Signal, 1)
+
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(quit)
+
+	<-quit
+
+	sm.Shutdown()
+}
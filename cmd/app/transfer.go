@@ -0,0 +1,272 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	. "github.com/zhunismp/intent-products-api/internal/core/domain/cause"
+	. "github.com/zhunismp/intent-products-api/internal/core/domain/product"
+	. "github.com/zhunismp/intent-products-api/internal/core/domain/tag"
+	. "github.com/zhunismp/intent-products-api/internal/core/domain/wishlist"
+)
+
+// wishlistFormatVersion is bumped whenever the file format changes incompatibly. Version 2
+// keeps the lists, version 1 files listed products only and are read into the default list.
+const wishlistFormatVersion = 2
+
+// wishlistFile is the format written by export and read by import. Ids and positions are
+// left out, lists keep their products in position order and are matched by name when
+// imported, the default list goes into the owner's default list.
+type wishlistFile struct {
+	Version    int            `json:"version"`
+	OwnerID    uint           `json:"ownerId"`
+	ExportedAt time.Time      `json:"exportedAt"`
+	Lists      []wishlistList `json:"lists,omitempty"`
+
+	// Products is the flat list of version 1 files
+	Products []wishlistProduct `json:"products,omitempty"`
+}
+
+type wishlistList struct {
+	Name      string            `json:"name"`
+	Emoji     string            `json:"emoji,omitempty"`
+	Color     string            `json:"color,omitempty"`
+	Archived  bool              `json:"archived,omitempty"`
+	IsDefault bool              `json:"isDefault,omitempty"`
+	Products  []wishlistProduct `json:"products"`
+}
+
+type wishlistProduct struct {
+	Name     string          `json:"name"`
+	ImageUrl string          `json:"imageUrl"`
+	Link     string          `json:"link"`
+	Price    float64         `json:"price"`
+	Status   string          `json:"status"`
+	Causes   []wishlistCause `json:"causes"`
+	Tags     []string        `json:"tags,omitempty"`
+
+	// CoolingOffHours is the product's own cooling-off, left out when it follows the owner's default
+	CoolingOffHours *int `json:"coolingOffHours,omitempty"`
+}
+
+// wishlistCause leaves polarity and weight out when missing, files from before they existed
+// import as pros of average weight.
+type wishlistCause struct {
+	Reason   string `json:"reason"`
+	Active   bool   `json:"active"`
+	Polarity string `json:"polarity,omitempty"`
+	Weight   int    `json:"weight,omitempty"`
+}
+
+func toWishlistProduct(p *Product) wishlistProduct {
+	causes := make([]wishlistCause, 0, len(p.Causes))
+	for _, c := range p.Causes {
+		causes = append(causes, wishlistCause{Reason: c.Reason, Active: c.Status, Polarity: c.Polarity, Weight: c.Weight})
+	}
+	tags := make([]string, 0, len(p.Tags))
+	for _, t := range p.Tags {
+		tags = append(tags, t.Name)
+	}
+	return wishlistProduct{
+		Name:     p.Name,
+		ImageUrl: p.ImageUrl,
+		Link:     p.Link,
+		Price:    p.Price,
+		Status:   p.Status,
+		Causes:   causes,
+		Tags:     tags,
+
+		CoolingOffHours: p.CoolingOffHours,
+	}
+}
+
+func fromWishlistProduct(p wishlistProduct) *Product {
+	causes := make([]*Cause, 0, len(p.Causes))
+	for _, c := range p.Causes {
+		causes = append(causes, &Cause{Reason: c.Reason, Status: c.Active, Polarity: c.Polarity, Weight: c.Weight})
+	}
+	tags := make([]*Tag, 0, len(p.Tags))
+	for _, name := range p.Tags {
+		tags = append(tags, &Tag{Name: name})
+	}
+	return &Product{
+		Name:     p.Name,
+		ImageUrl: p.ImageUrl,
+		Link:     p.Link,
+		Price:    p.Price,
+		Status:   p.Status,
+		Causes:   causes,
+		Tags:     tags,
+
+		CoolingOffHours: p.CoolingOffHours,
+	}
+}
+
+func runExport(args []string) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	ownerID := flags.Uint("owner", 0, "id of the owner to export (required)")
+	output := flags.String("o", "-", "file to write, - for stdout")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *ownerID == 0 {
+		fmt.Fprintln(os.Stderr, "-owner is required")
+		flags.Usage()
+		return 2
+	}
+
+	env, err := setupCommand(true)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer env.close()
+
+	lists, err := env.svc.product.ExportWishlists(context.Background(), *ownerID)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	file := wishlistFile{
+		Version:    wishlistFormatVersion,
+		OwnerID:    *ownerID,
+		ExportedAt: time.Now().UTC(),
+		Lists:      make([]wishlistList, 0, len(lists)),
+	}
+	exported := 0
+	for _, l := range lists {
+		products := make([]wishlistProduct, 0, len(l.Products))
+		for _, p := range l.Products {
+			products = append(products, toWishlistProduct(p))
+		}
+		exported += len(products)
+
+		file.Lists = append(file.Lists, wishlistList{
+			Name:      l.List.Name,
+			Emoji:     l.List.Emoji,
+			Color:     l.List.Color,
+			Archived:  l.List.Archived,
+			IsDefault: l.List.IsDefault,
+			Products:  products,
+		})
+	}
+
+	if *output == "-" {
+		if err := writeWishlist(os.Stdout, file); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		return 0
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	// a failed close can lose buffered data, the export only succeeded once it is closed
+	err = writeWishlist(f, file)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	fmt.Printf("exported %d product(s) in %d list(s) of owner %d to %s\n", exported, len(file.Lists), *ownerID, *output)
+	return 0
+}
+
+func writeWishlist(w io.Writer, file wishlistFile) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(file)
+}
+
+func runImport(args []string) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	ownerID := flags.Uint("owner", 0, "id of the owner to import into (required), may differ from the exported owner")
+	input := flags.String("i", "-", "file to read, - for stdin")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *ownerID == 0 {
+		fmt.Fprintln(os.Stderr, "-owner is required")
+		flags.Usage()
+		return 2
+	}
+
+	var r io.Reader = os.Stdin
+	if *input != "-" {
+		f, err := os.Open(*input)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var file wishlistFile
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&file); err != nil {
+		fmt.Fprintf(os.Stderr, "can not read wishlist: %v\n", err)
+		return 1
+	}
+
+	var lists []*ListExport
+	switch file.Version {
+	case 1:
+		// version 1 had no lists, everything goes into the default list as before
+		products := make([]*Product, 0, len(file.Products))
+		for _, p := range file.Products {
+			products = append(products, fromWishlistProduct(p))
+		}
+		lists = []*ListExport{{List: &Wishlist{IsDefault: true}, Products: products}}
+	case wishlistFormatVersion:
+		lists = make([]*ListExport, 0, len(file.Lists))
+		for _, l := range file.Lists {
+			products := make([]*Product, 0, len(l.Products))
+			for _, p := range l.Products {
+				products = append(products, fromWishlistProduct(p))
+			}
+			lists = append(lists, &ListExport{
+				List: &Wishlist{
+					Name:      l.Name,
+					Emoji:     l.Emoji,
+					Color:     l.Color,
+					Archived:  l.Archived,
+					IsDefault: l.IsDefault,
+				},
+				Products: products,
+			})
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "wishlist format version %d is not supported, expected at most %d\n", file.Version, wishlistFormatVersion)
+		return 1
+	}
+
+	env, err := setupCommand(true)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer env.close()
+
+	imported, err := env.svc.product.ImportWishlists(context.Background(), *ownerID, lists)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	fmt.Printf("imported %d product(s) into owner %d\n", imported, *ownerID)
+	return 0
+}
@@ -0,0 +1,114 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	pb "github.com/zhunismp/intent-products-api/internal/adapters/primary/grpc/pb/product/v1"
+	core "github.com/zhunismp/intent-products-api/internal/core/infrastructure/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// forwardedHeaders are passed to the gRPC server as metadata on top of the
+// headers grpc-gateway forwards by default.
+var forwardedHeaders = map[string]bool{
+	"x-api-key":    true,
+	"x-user-id":    true,
+	"x-request-id": true,
+}
+
+// GatewayServer serves the REST surface generated from the gRPC definitions and
+// proxies every call to the gRPC server.
+type GatewayServer struct {
+	cfg    core.AppConfigProvider
+	log    *slog.Logger
+	server *http.Server
+	conn   *grpc.ClientConn
+}
+
+func NewGatewayServer(cfg core.AppConfigProvider, slogLogger *slog.Logger) (*GatewayServer, error) {
+	if cfg == nil {
+		log.Fatal("server configuration is missing for gateway server initialization")
+	}
+	if slogLogger == nil {
+		log.Fatal("logger instance is missing for gateway server initialization")
+	}
+
+	grpcTarget := cfg.GetGatewayGrpcTarget()
+	conn, err := grpc.NewClient(grpcTarget, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("dial grpc server: %w", err)
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+	)
+
+	if err := pb.RegisterProductServiceHandler(context.Background(), mux, conn); err != nil {
+		return nil, fmt.Errorf("register product gateway: %w", err)
+	}
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf("%s:%s", cfg.GetServerHost(), cfg.GetGatewayPort()),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	slogLogger.Info("REST gateway initialized.", slog.String("grpcTarget", grpcTarget))
+
+	return &GatewayServer{
+		cfg:    cfg,
+		log:    slogLogger,
+		server: server,
+		conn:   conn,
+	}, nil
+}
+
+func (s *GatewayServer) Start() {
+	s.log.Info("attempting to start REST gateway...", slog.String("address", s.server.Addr))
+
+	go func() {
+		if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.Error("failed to start REST gateway listener", slog.Any("error", err))
+		}
+	}()
+
+	s.log.Info("REST gateway listener started.", slog.String("address", s.server.Addr))
+}
+
+func (s *GatewayServer) GracefulShutdown(ctx context.Context) error {
+	s.log.Info("gracefully shutting down REST gateway...")
+
+	err := errors.Join(s.server.Shutdown(ctx), s.conn.Close())
+	if err != nil {
+		s.log.Error("error during REST gateway shutdown")
+		return err
+	}
+
+	s.log.Info("REST gateway shutdown gracefully.")
+	return nil
+}
+
+func headerMatcher(key string) (string, bool) {
+	if forwardedHeaders[strings.ToLower(key)] {
+		return strings.ToLower(key), true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
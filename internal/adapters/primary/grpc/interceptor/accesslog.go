@@ -0,0 +1,35 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func AccessLogInterceptor(log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		ip := ""
+		if p, ok := peer.FromContext(ctx); ok {
+			ip = p.Addr.String()
+		}
+
+		log.Info(fmt.Sprintf("GRPC - %s", info.FullMethod),
+			slog.String("method", info.FullMethod),
+			slog.String("code", status.Code(err).String()),
+			slog.String("ip", ip),
+			slog.Int64("duration", time.Since(start).Milliseconds()),
+			slog.String("request_id", RequestIDFromContext(ctx)),
+		)
+
+		return resp, err
+	}
+}
@@ -0,0 +1,101 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	slogctx "github.com/veqryn/slog-context"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/apikey"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/auth"
+	"github.com/zhunismp/intent-products-api/internal/core/infrastructure/token"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authorizationKey = "authorization"
+	apiKeyKey        = "x-api-key"
+	legacyUserIDKey  = "x-user-id"
+)
+
+// AuthInterceptor authenticates the caller from the bearer token in the authorization
+// metadata or from x-api-key and puts the principal into the context. When legacyHeader
+// is set, a call without either may identify its user with x-user-id instead. API keys
+// need the read scope for Get and List methods and the write scope for the rest. Health
+// checks are left unauthenticated.
+func AuthInterceptor(verifier token.Verifier, apiKeys apikey.ApiKeyUsecase, legacyHeader bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if strings.HasPrefix(info.FullMethod, "/"+grpc_health_v1.Health_ServiceDesc.ServiceName+"/") {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		var principal *auth.Principal
+
+		if bearer, ok := bearerToken(first(md, authorizationKey)); ok {
+			p, err := verifier.Verify(ctx, bearer)
+			if err != nil {
+				return nil, err
+			}
+			principal = p
+		} else if key := first(md, apiKeyKey); key != "" {
+			p, err := apiKeys.Authenticate(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			principal = p
+		} else if userID := first(md, legacyUserIDKey); legacyHeader && userID != "" {
+			id, err := strconv.ParseUint(userID, 10, 64)
+			if err != nil || id == 0 {
+				return nil, apperrors.New(apperrors.ErrCodeUnauthorized, "x-user-id is invalid", err)
+			}
+			principal = &auth.Principal{
+				UserID:  uint(id),
+				Subject: userID,
+				Method:  auth.MethodLegacyHeader,
+			}
+		} else {
+			return nil, apperrors.New(apperrors.ErrCodeUnauthorized, "missing bearer token or api key", nil)
+		}
+
+		scope := requiredScope(info.FullMethod)
+		if !principal.Allows(scope) {
+			return nil, apperrors.New(apperrors.ErrCodeForbidden, fmt.Sprintf("api key lacks the %s scope", scope), nil)
+		}
+
+		ctx = slogctx.Append(ctx,
+			"user_id", principal.UserID,
+		)
+
+		return handler(auth.NewContext(ctx, principal), req)
+	}
+}
+
+// requiredScope derives the scope from the rpc name, full methods look like /package.Service/Method
+func requiredScope(fullMethod string) string {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if strings.HasPrefix(method, "Get") || strings.HasPrefix(method, "List") {
+		return auth.ScopeRead
+	}
+	return auth.ScopeWrite
+}
+
+func bearerToken(value string) (string, bool) {
+	scheme, token, ok := strings.Cut(value, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
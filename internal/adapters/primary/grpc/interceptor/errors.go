@@ -0,0 +1,52 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"runtime/debug"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorInterceptor converts AppError into a gRPC status, other errors that are
+// not a status already are hidden behind an internal error.
+func ErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		var appErr *apperrors.AppError
+		if errors.As(err, &appErr) {
+			return nil, status.Error(apperrors.MapToGrpcStatus(appErr.Code), appErr.Message)
+		}
+
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+
+		return nil, status.Error(codes.Internal, "something went wrong")
+	}
+}
+
+func RecoveryInterceptor(log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.ErrorContext(ctx, "recovered from panic in grpc handler",
+					slog.String("method", info.FullMethod),
+					slog.Any("panic", r),
+					slog.String("stack", string(debug.Stack())),
+				)
+				err = status.Error(codes.Internal, "something went wrong")
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,35 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	slogctx "github.com/veqryn/slog-context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const RequestIDKey = "x-request-id"
+
+type requestIDKey struct{}
+
+func RequestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+
+		reqID := uuid.NewString()
+
+		ctx = slogctx.Append(ctx,
+			"request_id", reqID,
+		)
+		ctx = context.WithValue(ctx, requestIDKey{}, reqID)
+
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDKey, reqID))
+
+		return handler(ctx, req)
+	}
+}
+
+func RequestIDFromContext(ctx context.Context) string {
+	reqID, _ := ctx.Value(requestIDKey{}).(string)
+	return reqID
+}
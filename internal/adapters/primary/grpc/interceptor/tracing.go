@@ -0,0 +1,77 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TraceInterceptor() grpc.UnaryServerInterceptor {
+	propagator := otel.GetTextMapPropagator()
+	tracer := otel.Tracer("grpc.server")
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		ctx = propagator.Extract(ctx, metadataCarrier(md))
+		ctx, span := tracer.Start(ctx, info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.RPCSystemGRPC,
+				attribute.String("rpc.method", info.FullMethod),
+				attribute.String("component", "grpc-handler"),
+			),
+		)
+		defer span.End()
+
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		duration := time.Since(start).Seconds()
+		code := status.Code(err)
+
+		span.SetAttributes(
+			semconv.RPCGRPCStatusCodeKey.Int(int(code)),
+			attribute.Float64("rpc.server.duration_seconds", duration),
+		)
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, code.String())
+		}
+
+		return resp, err
+	}
+}
+
+// metadataCarrier adapts gRPC metadata to the otel propagation carrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
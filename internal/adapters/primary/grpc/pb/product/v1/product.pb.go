@@ -0,0 +1,2831 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: product/v1/product.proto
+
+package productv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Product struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId    uint64                 `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ImageUrl   string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Link       string                 `protobuf:"bytes,5,opt,name=link,proto3" json:"link,omitempty"`
+	Price      float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Status     string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Causes     []*Cause               `protobuf:"bytes,8,rep,name=causes,proto3" json:"causes,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ListId     uint64                 `protobuf:"varint,11,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	Tags       []*Tag                 `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	Confidence float64                `protobuf:"fixed64,13,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// cooling_off_hours is the product's own cooling-off, unset follows the owner's default
+	CoolingOffHours *int32 `protobuf:"varint,14,opt,name=cooling_off_hours,json=coolingOffHours,proto3,oneof" json:"cooling_off_hours,omitempty"`
+	// cooling_off is only set while the product is not bought and has a wait
+	CoolingOff    *CoolingOff `protobuf:"bytes,15,opt,name=cooling_off,json=coolingOff,proto3" json:"cooling_off,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_product_v1_product_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Product) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Product) GetOwnerId() uint64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Product) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *Product) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Product) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Product) GetCauses() []*Cause {
+	if x != nil {
+		return x.Causes
+	}
+	return nil
+}
+
+func (x *Product) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetListId() uint64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *Product) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Product) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *Product) GetCoolingOffHours() int32 {
+	if x != nil && x.CoolingOffHours != nil {
+		return *x.CoolingOffHours
+	}
+	return 0
+}
+
+func (x *Product) GetCoolingOff() *CoolingOff {
+	if x != nil {
+		return x.CoolingOff
+	}
+	return nil
+}
+
+type CoolingOff struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Hours            int32                  `protobuf:"varint,1,opt,name=hours,proto3" json:"hours,omitempty"`
+	EndsAt           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	RemainingSeconds int64                  `protobuf:"varint,3,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CoolingOff) Reset() {
+	*x = CoolingOff{}
+	mi := &file_product_v1_product_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoolingOff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoolingOff) ProtoMessage() {}
+
+func (x *CoolingOff) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoolingOff.ProtoReflect.Descriptor instead.
+func (*CoolingOff) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CoolingOff) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *CoolingOff) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CoolingOff) GetRemainingSeconds() int64 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_product_v1_product_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Tag) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Cause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status        bool                   `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Polarity      string                 `protobuf:"bytes,6,opt,name=polarity,proto3" json:"polarity,omitempty"`
+	Weight        int32                  `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cause) Reset() {
+	*x = Cause{}
+	mi := &file_product_v1_product_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cause) ProtoMessage() {}
+
+func (x *Cause) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cause.ProtoReflect.Descriptor instead.
+func (*Cause) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Cause) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Cause) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Cause) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *Cause) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Cause) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Cause) GetPolarity() string {
+	if x != nil {
+		return x.Polarity
+	}
+	return ""
+}
+
+func (x *Cause) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type StatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     uint64                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ChangedBy     uint64                 `protobuf:"varint,3,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,4,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,5,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChange) Reset() {
+	*x = StatusChange{}
+	mi := &file_product_v1_product_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChange) ProtoMessage() {}
+
+func (x *StatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StatusChange) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StatusChange) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StatusChange) GetChangedBy() uint64 {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return 0
+}
+
+func (x *StatusChange) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *StatusChange) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *StatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// CreateProductRequest appends the product to list_id, or to the owner's
+// default list when it is not set.
+type CreateProductRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Price           float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Link            string                 `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	Reasons         []string               `protobuf:"bytes,4,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	ListId          uint64                 `protobuf:"varint,5,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	CoolingOffHours *int32                 `protobuf:"varint,6,opt,name=cooling_off_hours,json=coolingOffHours,proto3,oneof" json:"cooling_off_hours,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateProductRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetListId() uint64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetCoolingOffHours() int32 {
+	if x != nil && x.CoolingOffHours != nil {
+		return *x.CoolingOffHours
+	}
+	return 0
+}
+
+type CreateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductResponse) ProtoMessage() {}
+
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{6}
+}
+
+type GetProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetProductRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ListProductsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Page   int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size   int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	ListId *uint64                `protobuf:"varint,4,opt,name=list_id,json=listId,proto3,oneof" json:"list_id,omitempty"`
+	// tag_ids keeps the products carrying at least one of the tags
+	TagIds        []uint64 `protobuf:"varint,5,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListProductsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetListId() uint64 {
+	if x != nil && x.ListId != nil {
+		return *x.ListId
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetTagIds() []uint64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// GetProductsPageRequest walks the products in position order, an empty cursor
+// starts at the first page. Cursors come from a previous response.
+type GetProductsPageRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Size   int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	ListId *uint64                `protobuf:"varint,3,opt,name=list_id,json=listId,proto3,oneof" json:"list_id,omitempty"`
+	// tag_ids keeps the products carrying at least one of the tags
+	TagIds        []uint64 `protobuf:"varint,4,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	Cursor        string   `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductsPageRequest) Reset() {
+	*x = GetProductsPageRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsPageRequest) ProtoMessage() {}
+
+func (x *GetProductsPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsPageRequest.ProtoReflect.Descriptor instead.
+func (*GetProductsPageRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetProductsPageRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetProductsPageRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetProductsPageRequest) GetListId() uint64 {
+	if x != nil && x.ListId != nil {
+		return *x.ListId
+	}
+	return 0
+}
+
+func (x *GetProductsPageRequest) GetTagIds() []uint64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *GetProductsPageRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetProductsPageResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Products []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	// next_cursor and prev_cursor are empty when there is no page that way
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	PrevCursor    string `protobuf:"bytes,3,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductsPageResponse) Reset() {
+	*x = GetProductsPageResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsPageResponse) ProtoMessage() {}
+
+func (x *GetProductsPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsPageResponse.ProtoReflect.Descriptor instead.
+func (*GetProductsPageResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetProductsPageResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *GetProductsPageResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetProductsPageResponse) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+type GetSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSummaryRequest) Reset() {
+	*x = GetSummaryRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSummaryRequest) ProtoMessage() {}
+
+func (x *GetSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{13}
+}
+
+// GetSummaryResponse has a row for every status and every tag of the owner, even
+// without products.
+type GetSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*StatusSummary       `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Tags          []*TagSummary          `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPrice    float64                `protobuf:"fixed64,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSummaryResponse) Reset() {
+	*x = GetSummaryResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSummaryResponse) ProtoMessage() {}
+
+func (x *GetSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetSummaryResponse) GetStatuses() []*StatusSummary {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GetSummaryResponse) GetTags() []*TagSummary {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetSummaryResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetSummaryResponse) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+type StatusSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPrice    float64                `protobuf:"fixed64,3,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusSummary) Reset() {
+	*x = StatusSummary{}
+	mi := &file_product_v1_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusSummary) ProtoMessage() {}
+
+func (x *StatusSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusSummary.ProtoReflect.Descriptor instead.
+func (*StatusSummary) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StatusSummary) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatusSummary) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StatusSummary) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+// TagSummary counts a product with several tags for each of them.
+type TagSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         uint64                 `protobuf:"varint,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	TotalPrice    float64                `protobuf:"fixed64,4,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagSummary) Reset() {
+	*x = TagSummary{}
+	mi := &file_product_v1_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagSummary) ProtoMessage() {}
+
+func (x *TagSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagSummary.ProtoReflect.Descriptor instead.
+func (*TagSummary) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TagSummary) GetTagId() uint64 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+func (x *TagSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagSummary) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TagSummary) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+// UpdateProductRequest only changes the fields that are set.
+type UpdateProductRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Price           *float64               `protobuf:"fixed64,3,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	Link            *string                `protobuf:"bytes,4,opt,name=link,proto3,oneof" json:"link,omitempty"`
+	ImageUrl        *string                `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Status          *string                `protobuf:"bytes,6,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	CoolingOffHours *int32                 `protobuf:"varint,7,opt,name=cooling_off_hours,json=coolingOffHours,proto3,oneof" json:"cooling_off_hours,omitempty"`
+	// inherit_cooling_off drops the product's own cooling-off for the owner's default
+	InheritCoolingOff bool `protobuf:"varint,8,opt,name=inherit_cooling_off,json=inheritCoolingOff,proto3" json:"inherit_cooling_off,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateProductRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetPrice() float64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetLink() string {
+	if x != nil && x.Link != nil {
+		return *x.Link
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCoolingOffHours() int32 {
+	if x != nil && x.CoolingOffHours != nil {
+		return *x.CoolingOffHours
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetInheritCoolingOff() bool {
+	if x != nil {
+		return x.InheritCoolingOff
+	}
+	return false
+}
+
+type UpdateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type TransitionStatusRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// override_justification buys the product before its cooling-off ends, it is
+	// kept on the product as a cause
+	OverrideJustification string `protobuf:"bytes,3,opt,name=override_justification,json=overrideJustification,proto3" json:"override_justification,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TransitionStatusRequest) Reset() {
+	*x = TransitionStatusRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionStatusRequest) ProtoMessage() {}
+
+func (x *TransitionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionStatusRequest.ProtoReflect.Descriptor instead.
+func (*TransitionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TransitionStatusRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransitionStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TransitionStatusRequest) GetOverrideJustification() string {
+	if x != nil {
+		return x.OverrideJustification
+	}
+	return ""
+}
+
+type TransitionStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionStatusResponse) Reset() {
+	*x = TransitionStatusResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionStatusResponse) ProtoMessage() {}
+
+func (x *TransitionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionStatusResponse.ProtoReflect.Descriptor instead.
+func (*TransitionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TransitionStatusResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type GetStatusHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusHistoryRequest) Reset() {
+	*x = GetStatusHistoryRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusHistoryRequest) ProtoMessage() {}
+
+func (x *GetStatusHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetStatusHistoryRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetStatusHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*StatusChange        `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusHistoryResponse) Reset() {
+	*x = GetStatusHistoryResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusHistoryResponse) ProtoMessage() {}
+
+func (x *GetStatusHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetStatusHistoryResponse) GetChanges() []*StatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// MoveProductRequest moves a product by mode: "before" or "after" target_id,
+// "top", "bottom", or "index" for a zero based place in the list. Without a
+// mode it moves right after product_id_after, or to the top when that is not
+// set either. list_id moves the product into another list for top, bottom and
+// index, a target in another list moves it into the target's list.
+type MoveProductRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      uint64                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductIdAfter *uint64                `protobuf:"varint,2,opt,name=product_id_after,json=productIdAfter,proto3,oneof" json:"product_id_after,omitempty"`
+	Mode           string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	TargetId       *uint64                `protobuf:"varint,4,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`
+	Index          *int32                 `protobuf:"varint,5,opt,name=index,proto3,oneof" json:"index,omitempty"`
+	ListId         *uint64                `protobuf:"varint,6,opt,name=list_id,json=listId,proto3,oneof" json:"list_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MoveProductRequest) Reset() {
+	*x = MoveProductRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveProductRequest) ProtoMessage() {}
+
+func (x *MoveProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveProductRequest.ProtoReflect.Descriptor instead.
+func (*MoveProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MoveProductRequest) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *MoveProductRequest) GetProductIdAfter() uint64 {
+	if x != nil && x.ProductIdAfter != nil {
+		return *x.ProductIdAfter
+	}
+	return 0
+}
+
+func (x *MoveProductRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *MoveProductRequest) GetTargetId() uint64 {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return 0
+}
+
+func (x *MoveProductRequest) GetIndex() int32 {
+	if x != nil && x.Index != nil {
+		return *x.Index
+	}
+	return 0
+}
+
+func (x *MoveProductRequest) GetListId() uint64 {
+	if x != nil && x.ListId != nil {
+		return *x.ListId
+	}
+	return 0
+}
+
+type MoveProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveProductResponse) Reset() {
+	*x = MoveProductResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveProductResponse) ProtoMessage() {}
+
+func (x *MoveProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveProductResponse.ProtoReflect.Descriptor instead.
+func (*MoveProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{24}
+}
+
+// ReorderProductsRequest lists every product of list_id, or of the owner's default
+// list when it is not set, in the wanted order.
+type ReorderProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        uint64                 `protobuf:"varint,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	ProductIds    []uint64               `protobuf:"varint,2,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProductsRequest) Reset() {
+	*x = ReorderProductsRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProductsRequest) ProtoMessage() {}
+
+func (x *ReorderProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProductsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReorderProductsRequest) GetListId() uint64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *ReorderProductsRequest) GetProductIds() []uint64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type ReorderProductsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// moved counts the products that got a new position
+	Moved         int32 `protobuf:"varint,1,opt,name=moved,proto3" json:"moved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProductsResponse) Reset() {
+	*x = ReorderProductsResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProductsResponse) ProtoMessage() {}
+
+func (x *ReorderProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProductsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReorderProductsResponse) GetMoved() int32 {
+	if x != nil {
+		return x.Moved
+	}
+	return 0
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteProductRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{28}
+}
+
+type AddCausesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId uint64                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Reasons   []string               `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// polarity is pro or con, pro when empty
+	Polarity string `protobuf:"bytes,3,opt,name=polarity,proto3" json:"polarity,omitempty"`
+	// weight runs from 1 to 5, 3 when unset
+	Weight        int32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCausesRequest) Reset() {
+	*x = AddCausesRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCausesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCausesRequest) ProtoMessage() {}
+
+func (x *AddCausesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCausesRequest.ProtoReflect.Descriptor instead.
+func (*AddCausesRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddCausesRequest) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *AddCausesRequest) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *AddCausesRequest) GetPolarity() string {
+	if x != nil {
+		return x.Polarity
+	}
+	return ""
+}
+
+func (x *AddCausesRequest) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type AddCausesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCausesResponse) Reset() {
+	*x = AddCausesResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCausesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCausesResponse) ProtoMessage() {}
+
+func (x *AddCausesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCausesResponse.ProtoReflect.Descriptor instead.
+func (*AddCausesResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{30}
+}
+
+// UpdateCauseRequest only changes the fields that are set, status false marks a
+// reason that no longer applies.
+type UpdateCauseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     uint64                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	CauseId       uint64                 `protobuf:"varint,2,opt,name=cause_id,json=causeId,proto3" json:"cause_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	Status        *bool                  `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Polarity      *string                `protobuf:"bytes,5,opt,name=polarity,proto3,oneof" json:"polarity,omitempty"`
+	Weight        *int32                 `protobuf:"varint,6,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCauseRequest) Reset() {
+	*x = UpdateCauseRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCauseRequest) ProtoMessage() {}
+
+func (x *UpdateCauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCauseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCauseRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateCauseRequest) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *UpdateCauseRequest) GetCauseId() uint64 {
+	if x != nil {
+		return x.CauseId
+	}
+	return 0
+}
+
+func (x *UpdateCauseRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateCauseRequest) GetStatus() bool {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return false
+}
+
+func (x *UpdateCauseRequest) GetPolarity() string {
+	if x != nil && x.Polarity != nil {
+		return *x.Polarity
+	}
+	return ""
+}
+
+func (x *UpdateCauseRequest) GetWeight() int32 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+type UpdateCauseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cause         *Cause                 `protobuf:"bytes,1,opt,name=cause,proto3" json:"cause,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCauseResponse) Reset() {
+	*x = UpdateCauseResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCauseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCauseResponse) ProtoMessage() {}
+
+func (x *UpdateCauseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCauseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCauseResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateCauseResponse) GetCause() *Cause {
+	if x != nil {
+		return x.Cause
+	}
+	return nil
+}
+
+type DeleteCauseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     uint64                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	CauseId       uint64                 `protobuf:"varint,2,opt,name=cause_id,json=causeId,proto3" json:"cause_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCauseRequest) Reset() {
+	*x = DeleteCauseRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCauseRequest) ProtoMessage() {}
+
+func (x *DeleteCauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCauseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCauseRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteCauseRequest) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *DeleteCauseRequest) GetCauseId() uint64 {
+	if x != nil {
+		return x.CauseId
+	}
+	return 0
+}
+
+type DeleteCauseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCauseResponse) Reset() {
+	*x = DeleteCauseResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCauseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCauseResponse) ProtoMessage() {}
+
+func (x *DeleteCauseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCauseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCauseResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{34}
+}
+
+// ReorderCausesRequest lists every cause of the product in the wanted order.
+type ReorderCausesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     uint64                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	CauseIds      []uint64               `protobuf:"varint,2,rep,packed,name=cause_ids,json=causeIds,proto3" json:"cause_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCausesRequest) Reset() {
+	*x = ReorderCausesRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCausesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCausesRequest) ProtoMessage() {}
+
+func (x *ReorderCausesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCausesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCausesRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReorderCausesRequest) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReorderCausesRequest) GetCauseIds() []uint64 {
+	if x != nil {
+		return x.CauseIds
+	}
+	return nil
+}
+
+type ReorderCausesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Causes        []*Cause               `protobuf:"bytes,1,rep,name=causes,proto3" json:"causes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCausesResponse) Reset() {
+	*x = ReorderCausesResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCausesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCausesResponse) ProtoMessage() {}
+
+func (x *ReorderCausesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCausesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderCausesResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReorderCausesResponse) GetCauses() []*Cause {
+	if x != nil {
+		return x.Causes
+	}
+	return nil
+}
+
+// TagProductRequest adds tags of the product's owner to the product.
+type TagProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TagIds        []uint64               `protobuf:"varint,2,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagProductRequest) Reset() {
+	*x = TagProductRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagProductRequest) ProtoMessage() {}
+
+func (x *TagProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagProductRequest.ProtoReflect.Descriptor instead.
+func (*TagProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TagProductRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TagProductRequest) GetTagIds() []uint64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+type TagProductResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tags holds every tag of the product after tagging
+	Tags          []*Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagProductResponse) Reset() {
+	*x = TagProductResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagProductResponse) ProtoMessage() {}
+
+func (x *TagProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagProductResponse.ProtoReflect.Descriptor instead.
+func (*TagProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TagProductResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type UntagProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TagId         uint64                 `protobuf:"varint,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UntagProductRequest) Reset() {
+	*x = UntagProductRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UntagProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UntagProductRequest) ProtoMessage() {}
+
+func (x *UntagProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UntagProductRequest.ProtoReflect.Descriptor instead.
+func (*UntagProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UntagProductRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UntagProductRequest) GetTagId() uint64 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+type UntagProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UntagProductResponse) Reset() {
+	*x = UntagProductResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UntagProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UntagProductResponse) ProtoMessage() {}
+
+func (x *UntagProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UntagProductResponse.ProtoReflect.Descriptor instead.
+func (*UntagProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{40}
+}
+
+var File_product_v1_product_proto protoreflect.FileDescriptor
+
+const file_product_v1_product_proto_rawDesc = "" +
+	"\n" +
+	"\x18product/v1/product.proto\x12\x11intent.product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbb\x04\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\x04R\aownerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x12\n" +
+	"\x04link\x18\x05 \x01(\tR\x04link\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x120\n" +
+	"\x06causes\x18\b \x03(\v2\x18.intent.product.v1.CauseR\x06causes\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n" +
+	"\alist_id\x18\v \x01(\x04R\x06listId\x12*\n" +
+	"\x04tags\x18\f \x03(\v2\x16.intent.product.v1.TagR\x04tags\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\r \x01(\x01R\n" +
+	"confidence\x12/\n" +
+	"\x11cooling_off_hours\x18\x0e \x01(\x05H\x00R\x0fcoolingOffHours\x88\x01\x01\x12>\n" +
+	"\vcooling_off\x18\x0f \x01(\v2\x1d.intent.product.v1.CoolingOffR\n" +
+	"coolingOffB\x14\n" +
+	"\x12_cooling_off_hours\"\x84\x01\n" +
+	"\n" +
+	"CoolingOff\x12\x14\n" +
+	"\x05hours\x18\x01 \x01(\x05R\x05hours\x123\n" +
+	"\aends_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12+\n" +
+	"\x11remaining_seconds\x18\x03 \x01(\x03R\x10remainingSeconds\")\n" +
+	"\x03Tag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xf1\x01\n" +
+	"\x05Cause\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\bR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bpolarity\x18\x06 \x01(\tR\bpolarity\x12\x16\n" +
+	"\x06weight\x18\a \x01(\x05R\x06weight\"\xd5\x01\n" +
+	"\fStatusChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x04R\tproductId\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x03 \x01(\x04R\tchangedBy\x12\x1f\n" +
+	"\vfrom_status\x18\x04 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x05 \x01(\tR\btoStatus\x129\n" +
+	"\n" +
+	"changed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\xd0\x01\n" +
+	"\x14CreateProductRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x12\n" +
+	"\x04link\x18\x03 \x01(\tR\x04link\x12\x18\n" +
+	"\areasons\x18\x04 \x03(\tR\areasons\x12\x17\n" +
+	"\alist_id\x18\x05 \x01(\x04R\x06listId\x12/\n" +
+	"\x11cooling_off_hours\x18\x06 \x01(\x05H\x00R\x0fcoolingOffHours\x88\x01\x01B\x14\n" +
+	"\x12_cooling_off_hours\"\x17\n" +
+	"\x15CreateProductResponse\"#\n" +
+	"\x11GetProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"J\n" +
+	"\x12GetProductResponse\x124\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1a.intent.product.v1.ProductR\aproduct\"\x98\x01\n" +
+	"\x13ListProductsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\x12\x1c\n" +
+	"\alist_id\x18\x04 \x01(\x04H\x00R\x06listId\x88\x01\x01\x12\x17\n" +
+	"\atag_ids\x18\x05 \x03(\x04R\x06tagIdsB\n" +
+	"\n" +
+	"\b_list_id\"\x8c\x01\n" +
+	"\x14ListProductsResponse\x126\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1a.intent.product.v1.ProductR\bproducts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"\x9f\x01\n" +
+	"\x16GetProductsPageRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x1c\n" +
+	"\alist_id\x18\x03 \x01(\x04H\x00R\x06listId\x88\x01\x01\x12\x17\n" +
+	"\atag_ids\x18\x04 \x03(\x04R\x06tagIds\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursorB\n" +
+	"\n" +
+	"\b_list_id\"\x93\x01\n" +
+	"\x17GetProductsPageResponse\x126\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1a.intent.product.v1.ProductR\bproducts\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vprev_cursor\x18\x03 \x01(\tR\n" +
+	"prevCursor\"\x13\n" +
+	"\x11GetSummaryRequest\"\xbc\x01\n" +
+	"\x12GetSummaryResponse\x12<\n" +
+	"\bstatuses\x18\x01 \x03(\v2 .intent.product.v1.StatusSummaryR\bstatuses\x121\n" +
+	"\x04tags\x18\x02 \x03(\v2\x1d.intent.product.v1.TagSummaryR\x04tags\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x1f\n" +
+	"\vtotal_price\x18\x04 \x01(\x01R\n" +
+	"totalPrice\"^\n" +
+	"\rStatusSummary\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x1f\n" +
+	"\vtotal_price\x18\x03 \x01(\x01R\n" +
+	"totalPrice\"n\n" +
+	"\n" +
+	"TagSummary\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\x04R\x05tagId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x1f\n" +
+	"\vtotal_price\x18\x04 \x01(\x01R\n" +
+	"totalPrice\"\xde\x02\n" +
+	"\x14UpdateProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05price\x18\x03 \x01(\x01H\x01R\x05price\x88\x01\x01\x12\x17\n" +
+	"\x04link\x18\x04 \x01(\tH\x02R\x04link\x88\x01\x01\x12 \n" +
+	"\timage_url\x18\x05 \x01(\tH\x03R\bimageUrl\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x06 \x01(\tH\x04R\x06status\x88\x01\x01\x12/\n" +
+	"\x11cooling_off_hours\x18\a \x01(\x05H\x05R\x0fcoolingOffHours\x88\x01\x01\x12.\n" +
+	"\x13inherit_cooling_off\x18\b \x01(\bR\x11inheritCoolingOffB\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_priceB\a\n" +
+	"\x05_linkB\f\n" +
+	"\n" +
+	"_image_urlB\t\n" +
+	"\a_statusB\x14\n" +
+	"\x12_cooling_off_hours\"M\n" +
+	"\x15UpdateProductResponse\x124\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1a.intent.product.v1.ProductR\aproduct\"x\n" +
+	"\x17TransitionStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x125\n" +
+	"\x16override_justification\x18\x03 \x01(\tR\x15overrideJustification\"P\n" +
+	"\x18TransitionStatusResponse\x124\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1a.intent.product.v1.ProductR\aproduct\")\n" +
+	"\x17GetStatusHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"U\n" +
+	"\x18GetStatusHistoryResponse\x129\n" +
+	"\achanges\x18\x01 \x03(\v2\x1f.intent.product.v1.StatusChangeR\achanges\"\x8a\x02\n" +
+	"\x12MoveProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x04R\tproductId\x12-\n" +
+	"\x10product_id_after\x18\x02 \x01(\x04H\x00R\x0eproductIdAfter\x88\x01\x01\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12 \n" +
+	"\ttarget_id\x18\x04 \x01(\x04H\x01R\btargetId\x88\x01\x01\x12\x19\n" +
+	"\x05index\x18\x05 \x01(\x05H\x02R\x05index\x88\x01\x01\x12\x1c\n" +
+	"\alist_id\x18\x06 \x01(\x04H\x03R\x06listId\x88\x01\x01B\x13\n" +
+	"\x11_product_id_afterB\f\n" +
+	"\n" +
+	"_target_idB\b\n" +
+	"\x06_indexB\n" +
+	"\n" +
+	"\b_list_id\"\x15\n" +
+	"\x13MoveProductResponse\"R\n" +
+	"\x16ReorderProductsRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\x04R\x06listId\x12\x1f\n" +
+	"\vproduct_ids\x18\x02 \x03(\x04R\n" +
+	"productIds\"/\n" +
+	"\x17ReorderProductsResponse\x12\x14\n" +
+	"\x05moved\x18\x01 \x01(\x05R\x05moved\"&\n" +
+	"\x14DeleteProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x17\n" +
+	"\x15DeleteProductResponse\"\x7f\n" +
+	"\x10AddCausesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x04R\tproductId\x12\x18\n" +
+	"\areasons\x18\x02 \x03(\tR\areasons\x12\x1a\n" +
+	"\bpolarity\x18\x03 \x01(\tR\bpolarity\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x05R\x06weight\"\x13\n" +
+	"\x11AddCausesResponse\"\xf4\x01\n" +
+	"\x12UpdateCauseRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x04R\tproductId\x12\x19\n" +
+	"\bcause_id\x18\x02 \x01(\x04R\acauseId\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tH\x00R\x06reason\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\bH\x01R\x06status\x88\x01\x01\x12\x1f\n" +
+	"\bpolarity\x18\x05 \x01(\tH\x02R\bpolarity\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\x06 \x01(\x05H\x03R\x06weight\x88\x01\x01B\t\n" +
+	"\a_reasonB\t\n" +
+	"\a_statusB\v\n" +
+	"\t_polarityB\t\n" +
+	"\a_weight\"E\n" +
+	"\x13UpdateCauseResponse\x12.\n" +
+	"\x05cause\x18\x01 \x01(\v2\x18.intent.product.v1.CauseR\x05cause\"N\n" +
+	"\x12DeleteCauseRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x04R\tproductId\x12\x19\n" +
+	"\bcause_id\x18\x02 \x01(\x04R\acauseId\"\x15\n" +
+	"\x13DeleteCauseResponse\"R\n" +
+	"\x14ReorderCausesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x04R\tproductId\x12\x1b\n" +
+	"\tcause_ids\x18\x02 \x03(\x04R\bcauseIds\"I\n" +
+	"\x15ReorderCausesResponse\x120\n" +
+	"\x06causes\x18\x01 \x03(\v2\x18.intent.product.v1.CauseR\x06causes\"<\n" +
+	"\x11TagProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\atag_ids\x18\x02 \x03(\x04R\x06tagIds\"@\n" +
+	"\x12TagProductResponse\x12*\n" +
+	"\x04tags\x18\x01 \x03(\v2\x16.intent.product.v1.TagR\x04tags\"<\n" +
+	"\x13UntagProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x15\n" +
+	"\x06tag_id\x18\x02 \x01(\x04R\x05tagId\"\x16\n" +
+	"\x14UntagProductResponse2\x93\r\n" +
+	"\x0eProductService\x12b\n" +
+	"\rCreateProduct\x12'.intent.product.v1.CreateProductRequest\x1a(.intent.product.v1.CreateProductResponse\x12Y\n" +
+	"\n" +
+	"GetProduct\x12$.intent.product.v1.GetProductRequest\x1a%.intent.product.v1.GetProductResponse\x12_\n" +
+	"\fListProducts\x12&.intent.product.v1.ListProductsRequest\x1a'.intent.product.v1.ListProductsResponse\x12h\n" +
+	"\x0fGetProductsPage\x12).intent.product.v1.GetProductsPageRequest\x1a*.intent.product.v1.GetProductsPageResponse\x12Y\n" +
+	"\n" +
+	"GetSummary\x12$.intent.product.v1.GetSummaryRequest\x1a%.intent.product.v1.GetSummaryResponse\x12b\n" +
+	"\rUpdateProduct\x12'.intent.product.v1.UpdateProductRequest\x1a(.intent.product.v1.UpdateProductResponse\x12k\n" +
+	"\x10TransitionStatus\x12*.intent.product.v1.TransitionStatusRequest\x1a+.intent.product.v1.TransitionStatusResponse\x12k\n" +
+	"\x10GetStatusHistory\x12*.intent.product.v1.GetStatusHistoryRequest\x1a+.intent.product.v1.GetStatusHistoryResponse\x12\\\n" +
+	"\vMoveProduct\x12%.intent.product.v1.MoveProductRequest\x1a&.intent.product.v1.MoveProductResponse\x12h\n" +
+	"\x0fReorderProducts\x12).intent.product.v1.ReorderProductsRequest\x1a*.intent.product.v1.ReorderProductsResponse\x12b\n" +
+	"\rDeleteProduct\x12'.intent.product.v1.DeleteProductRequest\x1a(.intent.product.v1.DeleteProductResponse\x12V\n" +
+	"\tAddCauses\x12#.intent.product.v1.AddCausesRequest\x1a$.intent.product.v1.AddCausesResponse\x12\\\n" +
+	"\vUpdateCause\x12%.intent.product.v1.UpdateCauseRequest\x1a&.intent.product.v1.UpdateCauseResponse\x12\\\n" +
+	"\vDeleteCause\x12%.intent.product.v1.DeleteCauseRequest\x1a&.intent.product.v1.DeleteCauseResponse\x12b\n" +
+	"\rReorderCauses\x12'.intent.product.v1.ReorderCausesRequest\x1a(.intent.product.v1.ReorderCausesResponse\x12Y\n" +
+	"\n" +
+	"TagProduct\x12$.intent.product.v1.TagProductRequest\x1a%.intent.product.v1.TagProductResponse\x12_\n" +
+	"\fUntagProduct\x12&.intent.product.v1.UntagProductRequest\x1a'.intent.product.v1.UntagProductResponseB`Z^github.com/zhunismp/intent-products-api/internal/adapters/primary/grpc/pb/product/v1;productv1b\x06proto3"
+
+var (
+	file_product_v1_product_proto_rawDescOnce sync.Once
+	file_product_v1_product_proto_rawDescData []byte
+)
+
+func file_product_v1_product_proto_rawDescGZIP() []byte {
+	file_product_v1_product_proto_rawDescOnce.Do(func() {
+		file_product_v1_product_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_product_v1_product_proto_rawDesc), len(file_product_v1_product_proto_rawDesc)))
+	})
+	return file_product_v1_product_proto_rawDescData
+}
+
+var file_product_v1_product_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_product_v1_product_proto_goTypes = []any{
+	(*Product)(nil),                  // 0: intent.product.v1.Product
+	(*CoolingOff)(nil),               // 1: intent.product.v1.CoolingOff
+	(*Tag)(nil),                      // 2: intent.product.v1.Tag
+	(*Cause)(nil),                    // 3: intent.product.v1.Cause
+	(*StatusChange)(nil),             // 4: intent.product.v1.StatusChange
+	(*CreateProductRequest)(nil),     // 5: intent.product.v1.CreateProductRequest
+	(*CreateProductResponse)(nil),    // 6: intent.product.v1.CreateProductResponse
+	(*GetProductRequest)(nil),        // 7: intent.product.v1.GetProductRequest
+	(*GetProductResponse)(nil),       // 8: intent.product.v1.GetProductResponse
+	(*ListProductsRequest)(nil),      // 9: intent.product.v1.ListProductsRequest
+	(*ListProductsResponse)(nil),     // 10: intent.product.v1.ListProductsResponse
+	(*GetProductsPageRequest)(nil),   // 11: intent.product.v1.GetProductsPageRequest
+	(*GetProductsPageResponse)(nil),  // 12: intent.product.v1.GetProductsPageResponse
+	(*GetSummaryRequest)(nil),        // 13: intent.product.v1.GetSummaryRequest
+	(*GetSummaryResponse)(nil),       // 14: intent.product.v1.GetSummaryResponse
+	(*StatusSummary)(nil),            // 15: intent.product.v1.StatusSummary
+	(*TagSummary)(nil),               // 16: intent.product.v1.TagSummary
+	(*UpdateProductRequest)(nil),     // 17: intent.product.v1.UpdateProductRequest
+	(*UpdateProductResponse)(nil),    // 18: intent.product.v1.UpdateProductResponse
+	(*TransitionStatusRequest)(nil),  // 19: intent.product.v1.TransitionStatusRequest
+	(*TransitionStatusResponse)(nil), // 20: intent.product.v1.TransitionStatusResponse
+	(*GetStatusHistoryRequest)(nil),  // 21: intent.product.v1.GetStatusHistoryRequest
+	(*GetStatusHistoryResponse)(nil), // 22: intent.product.v1.GetStatusHistoryResponse
+	(*MoveProductRequest)(nil),       // 23: intent.product.v1.MoveProductRequest
+	(*MoveProductResponse)(nil),      // 24: intent.product.v1.MoveProductResponse
+	(*ReorderProductsRequest)(nil),   // 25: intent.product.v1.ReorderProductsRequest
+	(*ReorderProductsResponse)(nil),  // 26: intent.product.v1.ReorderProductsResponse
+	(*DeleteProductRequest)(nil),     // 27: intent.product.v1.DeleteProductRequest
+	(*DeleteProductResponse)(nil),    // 28: intent.product.v1.DeleteProductResponse
+	(*AddCausesRequest)(nil),         // 29: intent.product.v1.AddCausesRequest
+	(*AddCausesResponse)(nil),        // 30: intent.product.v1.AddCausesResponse
+	(*UpdateCauseRequest)(nil),       // 31: intent.product.v1.UpdateCauseRequest
+	(*UpdateCauseResponse)(nil),      // 32: intent.product.v1.UpdateCauseResponse
+	(*DeleteCauseRequest)(nil),       // 33: intent.product.v1.DeleteCauseRequest
+	(*DeleteCauseResponse)(nil),      // 34: intent.product.v1.DeleteCauseResponse
+	(*ReorderCausesRequest)(nil),     // 35: intent.product.v1.ReorderCausesRequest
+	(*ReorderCausesResponse)(nil),    // 36: intent.product.v1.ReorderCausesResponse
+	(*TagProductRequest)(nil),        // 37: intent.product.v1.TagProductRequest
+	(*TagProductResponse)(nil),       // 38: intent.product.v1.TagProductResponse
+	(*UntagProductRequest)(nil),      // 39: intent.product.v1.UntagProductRequest
+	(*UntagProductResponse)(nil),     // 40: intent.product.v1.UntagProductResponse
+	(*timestamppb.Timestamp)(nil),    // 41: google.protobuf.Timestamp
+}
+var file_product_v1_product_proto_depIdxs = []int32{
+	3,  // 0: intent.product.v1.Product.causes:type_name -> intent.product.v1.Cause
+	41, // 1: intent.product.v1.Product.created_at:type_name -> google.protobuf.Timestamp
+	41, // 2: intent.product.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: intent.product.v1.Product.tags:type_name -> intent.product.v1.Tag
+	1,  // 4: intent.product.v1.Product.cooling_off:type_name -> intent.product.v1.CoolingOff
+	41, // 5: intent.product.v1.CoolingOff.ends_at:type_name -> google.protobuf.Timestamp
+	41, // 6: intent.product.v1.Cause.created_at:type_name -> google.protobuf.Timestamp
+	41, // 7: intent.product.v1.Cause.updated_at:type_name -> google.protobuf.Timestamp
+	41, // 8: intent.product.v1.StatusChange.changed_at:type_name -> google.protobuf.Timestamp
+	0,  // 9: intent.product.v1.GetProductResponse.product:type_name -> intent.product.v1.Product
+	0,  // 10: intent.product.v1.ListProductsResponse.products:type_name -> intent.product.v1.Product
+	0,  // 11: intent.product.v1.GetProductsPageResponse.products:type_name -> intent.product.v1.Product
+	15, // 12: intent.product.v1.GetSummaryResponse.statuses:type_name -> intent.product.v1.StatusSummary
+	16, // 13: intent.product.v1.GetSummaryResponse.tags:type_name -> intent.product.v1.TagSummary
+	0,  // 14: intent.product.v1.UpdateProductResponse.product:type_name -> intent.product.v1.Product
+	0,  // 15: intent.product.v1.TransitionStatusResponse.product:type_name -> intent.product.v1.Product
+	4,  // 16: intent.product.v1.GetStatusHistoryResponse.changes:type_name -> intent.product.v1.StatusChange
+	3,  // 17: intent.product.v1.UpdateCauseResponse.cause:type_name -> intent.product.v1.Cause
+	3,  // 18: intent.product.v1.ReorderCausesResponse.causes:type_name -> intent.product.v1.Cause
+	2,  // 19: intent.product.v1.TagProductResponse.tags:type_name -> intent.product.v1.Tag
+	5,  // 20: intent.product.v1.ProductService.CreateProduct:input_type -> intent.product.v1.CreateProductRequest
+	7,  // 21: intent.product.v1.ProductService.GetProduct:input_type -> intent.product.v1.GetProductRequest
+	9,  // 22: intent.product.v1.ProductService.ListProducts:input_type -> intent.product.v1.ListProductsRequest
+	11, // 23: intent.product.v1.ProductService.GetProductsPage:input_type -> intent.product.v1.GetProductsPageRequest
+	13, // 24: intent.product.v1.ProductService.GetSummary:input_type -> intent.product.v1.GetSummaryRequest
+	17, // 25: intent.product.v1.ProductService.UpdateProduct:input_type -> intent.product.v1.UpdateProductRequest
+	19, // 26: intent.product.v1.ProductService.TransitionStatus:input_type -> intent.product.v1.TransitionStatusRequest
+	21, // 27: intent.product.v1.ProductService.GetStatusHistory:input_type -> intent.product.v1.GetStatusHistoryRequest
+	23, // 28: intent.product.v1.ProductService.MoveProduct:input_type -> intent.product.v1.MoveProductRequest
+	25, // 29: intent.product.v1.ProductService.ReorderProducts:input_type -> intent.product.v1.ReorderProductsRequest
+	27, // 30: intent.product.v1.ProductService.DeleteProduct:input_type -> intent.product.v1.DeleteProductRequest
+	29, // 31: intent.product.v1.ProductService.AddCauses:input_type -> intent.product.v1.AddCausesRequest
+	31, // 32: intent.product.v1.ProductService.UpdateCause:input_type -> intent.product.v1.UpdateCauseRequest
+	33, // 33: intent.product.v1.ProductService.DeleteCause:input_type -> intent.product.v1.DeleteCauseRequest
+	35, // 34: intent.product.v1.ProductService.ReorderCauses:input_type -> intent.product.v1.ReorderCausesRequest
+	37, // 35: intent.product.v1.ProductService.TagProduct:input_type -> intent.product.v1.TagProductRequest
+	39, // 36: intent.product.v1.ProductService.UntagProduct:input_type -> intent.product.v1.UntagProductRequest
+	6,  // 37: intent.product.v1.ProductService.CreateProduct:output_type -> intent.product.v1.CreateProductResponse
+	8,  // 38: intent.product.v1.ProductService.GetProduct:output_type -> intent.product.v1.GetProductResponse
+	10, // 39: intent.product.v1.ProductService.ListProducts:output_type -> intent.product.v1.ListProductsResponse
+	12, // 40: intent.product.v1.ProductService.GetProductsPage:output_type -> intent.product.v1.GetProductsPageResponse
+	14, // 41: intent.product.v1.ProductService.GetSummary:output_type -> intent.product.v1.GetSummaryResponse
+	18, // 42: intent.product.v1.ProductService.UpdateProduct:output_type -> intent.product.v1.UpdateProductResponse
+	20, // 43: intent.product.v1.ProductService.TransitionStatus:output_type -> intent.product.v1.TransitionStatusResponse
+	22, // 44: intent.product.v1.ProductService.GetStatusHistory:output_type -> intent.product.v1.GetStatusHistoryResponse
+	24, // 45: intent.product.v1.ProductService.MoveProduct:output_type -> intent.product.v1.MoveProductResponse
+	26, // 46: intent.product.v1.ProductService.ReorderProducts:output_type -> intent.product.v1.ReorderProductsResponse
+	28, // 47: intent.product.v1.ProductService.DeleteProduct:output_type -> intent.product.v1.DeleteProductResponse
+	30, // 48: intent.product.v1.ProductService.AddCauses:output_type -> intent.product.v1.AddCausesResponse
+	32, // 49: intent.product.v1.ProductService.UpdateCause:output_type -> intent.product.v1.UpdateCauseResponse
+	34, // 50: intent.product.v1.ProductService.DeleteCause:output_type -> intent.product.v1.DeleteCauseResponse
+	36, // 51: intent.product.v1.ProductService.ReorderCauses:output_type -> intent.product.v1.ReorderCausesResponse
+	38, // 52: intent.product.v1.ProductService.TagProduct:output_type -> intent.product.v1.TagProductResponse
+	40, // 53: intent.product.v1.ProductService.UntagProduct:output_type -> intent.product.v1.UntagProductResponse
+	37, // [37:54] is the sub-list for method output_type
+	20, // [20:37] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_product_v1_product_proto_init() }
+func file_product_v1_product_proto_init() {
+	if File_product_v1_product_proto != nil {
+		return
+	}
+	file_product_v1_product_proto_msgTypes[0].OneofWrappers = []any{}
+	file_product_v1_product_proto_msgTypes[5].OneofWrappers = []any{}
+	file_product_v1_product_proto_msgTypes[9].OneofWrappers = []any{}
+	file_product_v1_product_proto_msgTypes[11].OneofWrappers = []any{}
+	file_product_v1_product_proto_msgTypes[17].OneofWrappers = []any{}
+	file_product_v1_product_proto_msgTypes[23].OneofWrappers = []any{}
+	file_product_v1_product_proto_msgTypes[31].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_v1_product_proto_rawDesc), len(file_product_v1_product_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   41,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_product_v1_product_proto_goTypes,
+		DependencyIndexes: file_product_v1_product_proto_depIdxs,
+		MessageInfos:      file_product_v1_product_proto_msgTypes,
+	}.Build()
+	File_product_v1_product_proto = out.File
+	file_product_v1_product_proto_goTypes = nil
+	file_product_v1_product_proto_depIdxs = nil
+}
@@ -0,0 +1,384 @@
+package product
+
+import (
+	"context"
+	"log/slog"
+
+	pb "github.com/zhunismp/intent-products-api/internal/adapters/primary/grpc/pb/product/v1"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/cause"
+	core "github.com/zhunismp/intent-products-api/internal/core/domain/product"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/auth"
+)
+
+type ProductGrpcHandler struct {
+	pb.UnimplementedProductServiceServer
+
+	productSvc core.ProductUsecase
+	logger     *slog.Logger
+}
+
+func NewProductGrpcHandler(productSvc core.ProductUsecase, logger *slog.Logger) *ProductGrpcHandler {
+	return &ProductGrpcHandler{
+		productSvc: productSvc,
+		logger:     logger,
+	}
+}
+
+func (h *ProductGrpcHandler) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetTitle() == "" {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "title is required", nil)
+	}
+
+	if err := h.productSvc.CreateProduct(ctx, ownerID, uint(req.GetListId()), req.GetTitle(), req.GetPrice(), req.GetLink(), req.GetReasons(), fromProtoHours(req.CoolingOffHours)); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateProductResponse{}, nil
+}
+
+func (h *ProductGrpcHandler) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := h.productSvc.GetProduct(ctx, ownerID, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetProductResponse{Product: toProtoProduct(product)}, nil
+}
+
+func (h *ProductGrpcHandler) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// default page and size
+	filter := &core.Filter{
+		Page: 1,
+		Size: 20,
+	}
+	if req.GetStatus() != "" {
+		filter.Statuses = []string{req.GetStatus()}
+	}
+	if req.GetPage() > 0 {
+		filter.Page = int(req.GetPage())
+	}
+	if req.GetSize() > 0 {
+		filter.Size = int(req.GetSize())
+	}
+	if req.ListId != nil {
+		listID := uint(req.GetListId())
+		filter.ListID = &listID
+	}
+	for _, id := range req.GetTagIds() {
+		filter.TagIDs = append(filter.TagIDs, uint(id))
+	}
+
+	page, err := h.productSvc.GetAllProducts(ctx, ownerID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListProductsResponse{
+		Products: toProtoProducts(page.Items),
+		Total:    page.Total,
+		Page:     int32(page.Page),
+		Size:     int32(page.Size),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) GetProductsPage(ctx context.Context, req *pb.GetProductsPageRequest) (*pb.GetProductsPageResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// default size
+	filter := &core.CursorFilter{
+		Filter: core.Filter{Size: 20},
+		Cursor: req.GetCursor(),
+	}
+	if req.GetStatus() != "" {
+		filter.Statuses = []string{req.GetStatus()}
+	}
+	if req.GetSize() > 0 {
+		filter.Size = int(req.GetSize())
+	}
+	if req.ListId != nil {
+		listID := uint(req.GetListId())
+		filter.ListID = &listID
+	}
+	for _, id := range req.GetTagIds() {
+		filter.TagIDs = append(filter.TagIDs, uint(id))
+	}
+
+	page, err := h.productSvc.GetProductsPage(ctx, ownerID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetProductsPageResponse{
+		Products:   toProtoProducts(page.Items),
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+	}, nil
+}
+
+func (h *ProductGrpcHandler) GetSummary(ctx context.Context, req *pb.GetSummaryRequest) (*pb.GetSummaryResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := h.productSvc.GetSummary(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoSummary(summary), nil
+}
+
+func (h *ProductGrpcHandler) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := h.productSvc.UpdateProduct(ctx, ownerID, uint(req.GetId()), toPatch(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateProductResponse{Product: toProtoProduct(product)}, nil
+}
+
+func (h *ProductGrpcHandler) TransitionStatus(ctx context.Context, req *pb.TransitionStatusRequest) (*pb.TransitionStatusResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var override *core.Override
+	if req.GetOverrideJustification() != "" {
+		override = &core.Override{Justification: req.GetOverrideJustification()}
+	}
+
+	product, err := h.productSvc.TransitionStatus(ctx, ownerID, uint(req.GetId()), req.GetStatus(), override)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TransitionStatusResponse{Product: toProtoProduct(product)}, nil
+}
+
+func (h *ProductGrpcHandler) GetStatusHistory(ctx context.Context, req *pb.GetStatusHistoryRequest) (*pb.GetStatusHistoryResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := h.productSvc.GetStatusHistory(ctx, ownerID, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetStatusHistoryResponse{Changes: toProtoStatusChanges(changes)}, nil
+}
+
+func (h *ProductGrpcHandler) MoveProduct(ctx context.Context, req *pb.MoveProductRequest) (*pb.MoveProductResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := &core.MoveCommand{
+		ProductID: uint(req.GetProductId()),
+		Mode:      req.GetMode(),
+	}
+	if req.TargetId != nil {
+		id := uint(req.GetTargetId())
+		cmd.TargetID = &id
+	} else if req.ProductIdAfter != nil {
+		id := uint(req.GetProductIdAfter())
+		cmd.TargetID = &id
+	}
+	if req.Index != nil {
+		index := int(req.GetIndex())
+		cmd.Index = &index
+	}
+	if req.ListId != nil {
+		listID := uint(req.GetListId())
+		cmd.ListID = &listID
+	}
+
+	// without a mode the request keeps its original meaning
+	if cmd.Mode == "" {
+		cmd.Mode = core.MoveTop
+		if req.ProductIdAfter != nil {
+			cmd.Mode = core.MoveAfter
+		}
+	}
+
+	if err := h.productSvc.Move(ctx, ownerID, cmd); err != nil {
+		return nil, err
+	}
+
+	return &pb.MoveProductResponse{}, nil
+}
+
+func (h *ProductGrpcHandler) ReorderProducts(ctx context.Context, req *pb.ReorderProductsRequest) (*pb.ReorderProductsResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	productIDs := make([]uint, 0, len(req.GetProductIds()))
+	for _, id := range req.GetProductIds() {
+		productIDs = append(productIDs, uint(id))
+	}
+
+	moved, err := h.productSvc.Reorder(ctx, ownerID, uint(req.GetListId()), productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReorderProductsResponse{Moved: int32(moved)}, nil
+}
+
+func (h *ProductGrpcHandler) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.productSvc.DeleteProduct(ctx, ownerID, uint(req.GetId())); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteProductResponse{}, nil
+}
+
+func (h *ProductGrpcHandler) AddCauses(ctx context.Context, req *pb.AddCausesRequest) (*pb.AddCausesResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetProductId() == 0 || len(req.GetReasons()) == 0 {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "product id and reasons are required", nil)
+	}
+
+	if err := h.productSvc.AddCauses(ctx, ownerID, uint(req.GetProductId()), req.GetReasons(), req.GetPolarity(), int(req.GetWeight())); err != nil {
+		return nil, err
+	}
+
+	return &pb.AddCausesResponse{}, nil
+}
+
+func (h *ProductGrpcHandler) UpdateCause(ctx context.Context, req *pb.UpdateCauseRequest) (*pb.UpdateCauseResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := &cause.CausePatch{
+		Reason:   req.Reason,
+		Status:   req.Status,
+		Polarity: req.Polarity,
+	}
+	if req.Weight != nil {
+		weight := int(req.GetWeight())
+		patch.Weight = &weight
+	}
+
+	updated, err := h.productSvc.UpdateCause(ctx, ownerID, uint(req.GetProductId()), uint(req.GetCauseId()), patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateCauseResponse{Cause: toProtoCause(updated)}, nil
+}
+
+func (h *ProductGrpcHandler) DeleteCause(ctx context.Context, req *pb.DeleteCauseRequest) (*pb.DeleteCauseResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.productSvc.DeleteCause(ctx, ownerID, uint(req.GetProductId()), uint(req.GetCauseId())); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteCauseResponse{}, nil
+}
+
+func (h *ProductGrpcHandler) ReorderCauses(ctx context.Context, req *pb.ReorderCausesRequest) (*pb.ReorderCausesResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	causeIDs := make([]uint, 0, len(req.GetCauseIds()))
+	for _, id := range req.GetCauseIds() {
+		causeIDs = append(causeIDs, uint(id))
+	}
+
+	causes, err := h.productSvc.ReorderCauses(ctx, ownerID, uint(req.GetProductId()), causeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.Cause, 0, len(causes))
+	for _, c := range causes {
+		result = append(result, toProtoCause(c))
+	}
+
+	return &pb.ReorderCausesResponse{Causes: result}, nil
+}
+
+func (h *ProductGrpcHandler) TagProduct(ctx context.Context, req *pb.TagProductRequest) (*pb.TagProductResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tagIDs := make([]uint, 0, len(req.GetTagIds()))
+	for _, id := range req.GetTagIds() {
+		tagIDs = append(tagIDs, uint(id))
+	}
+
+	tags, err := h.productSvc.TagProduct(ctx, ownerID, uint(req.GetId()), tagIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.Tag, 0, len(tags))
+	for _, t := range tags {
+		result = append(result, toProtoTag(t))
+	}
+
+	return &pb.TagProductResponse{Tags: result}, nil
+}
+
+func (h *ProductGrpcHandler) UntagProduct(ctx context.Context, req *pb.UntagProductRequest) (*pb.UntagProductResponse, error) {
+	ownerID, err := auth.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.productSvc.UntagProduct(ctx, ownerID, uint(req.GetId()), uint(req.GetTagId())); err != nil {
+		return nil, err
+	}
+
+	return &pb.UntagProductResponse{}, nil
+}
@@ -0,0 +1,154 @@
+package product
+
+import (
+	pb "github.com/zhunismp/intent-products-api/internal/adapters/primary/grpc/pb/product/v1"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/cause"
+	core "github.com/zhunismp/intent-products-api/internal/core/domain/product"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/tag"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toProtoProduct(p *core.Product) *pb.Product {
+	if p == nil {
+		return nil
+	}
+
+	causes := make([]*pb.Cause, 0, len(p.Causes))
+	for _, c := range p.Causes {
+		causes = append(causes, toProtoCause(c))
+	}
+
+	tags := make([]*pb.Tag, 0, len(p.Tags))
+	for _, t := range p.Tags {
+		tags = append(tags, toProtoTag(t))
+	}
+
+	return &pb.Product{
+		Id:              uint64(p.ID),
+		OwnerId:         uint64(p.OwnerID),
+		ListId:          uint64(p.ListID),
+		Name:            p.Name,
+		ImageUrl:        p.ImageUrl,
+		Link:            p.Link,
+		Price:           p.Price,
+		Status:          p.Status,
+		Causes:          causes,
+		Tags:            tags,
+		Confidence:      p.Confidence,
+		CoolingOffHours: toProtoHours(p.CoolingOffHours),
+		CoolingOff:      toProtoCoolingOff(p.CoolingOff),
+		CreatedAt:       timestamppb.New(p.CreatedAt),
+		UpdatedAt:       timestamppb.New(p.UpdatedAt),
+	}
+}
+
+func toProtoProducts(products []*core.Product) []*pb.Product {
+	result := make([]*pb.Product, 0, len(products))
+	for _, p := range products {
+		result = append(result, toProtoProduct(p))
+	}
+	return result
+}
+
+func toProtoCoolingOff(c *core.CoolingOff) *pb.CoolingOff {
+	if c == nil {
+		return nil
+	}
+
+	return &pb.CoolingOff{
+		Hours:            int32(c.Hours),
+		EndsAt:           timestamppb.New(c.EndsAt),
+		RemainingSeconds: c.RemainingSeconds,
+	}
+}
+
+func toProtoHours(hours *int) *int32 {
+	if hours == nil {
+		return nil
+	}
+	h := int32(*hours)
+	return &h
+}
+
+func fromProtoHours(hours *int32) *int {
+	if hours == nil {
+		return nil
+	}
+	h := int(*hours)
+	return &h
+}
+
+func toProtoTag(t *tag.Tag) *pb.Tag {
+	return &pb.Tag{
+		Id:   uint64(t.ID),
+		Name: t.Name,
+	}
+}
+
+func toProtoCause(c *cause.Cause) *pb.Cause {
+	return &pb.Cause{
+		Id:        uint64(c.ID),
+		Reason:    c.Reason,
+		Status:    c.Status,
+		Polarity:  c.Polarity,
+		Weight:    int32(c.Weight),
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		UpdatedAt: timestamppb.New(c.UpdatedAt),
+	}
+}
+
+func toProtoStatusChanges(changes []*core.StatusChange) []*pb.StatusChange {
+	result := make([]*pb.StatusChange, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, &pb.StatusChange{
+			Id:         uint64(c.ID),
+			ProductId:  uint64(c.ProductID),
+			ChangedBy:  uint64(c.ChangedBy),
+			FromStatus: c.FromStatus,
+			ToStatus:   c.ToStatus,
+			ChangedAt:  timestamppb.New(c.ChangedAt),
+		})
+	}
+	return result
+}
+
+func toProtoSummary(s *core.ProductSummary) *pb.GetSummaryResponse {
+	statuses := make([]*pb.StatusSummary, 0, len(s.Statuses))
+	for _, row := range s.Statuses {
+		statuses = append(statuses, &pb.StatusSummary{
+			Status:     row.Status,
+			Count:      row.Count,
+			TotalPrice: row.TotalPrice,
+		})
+	}
+
+	tags := make([]*pb.TagSummary, 0, len(s.Tags))
+	for _, row := range s.Tags {
+		tags = append(tags, &pb.TagSummary{
+			TagId:      uint64(row.TagID),
+			Name:       row.Name,
+			Count:      row.Count,
+			TotalPrice: row.TotalPrice,
+		})
+	}
+
+	return &pb.GetSummaryResponse{
+		Statuses:   statuses,
+		Tags:       tags,
+		Count:      s.Count,
+		TotalPrice: s.TotalPrice,
+	}
+}
+
+func toPatch(req *pb.UpdateProductRequest) *core.Patch {
+	return &core.Patch{
+		Name:     req.Name,
+		Price:    req.Price,
+		Link:     req.Link,
+		ImageUrl: req.ImageUrl,
+		Status:   req.Status,
+
+		CoolingOffHours:   fromProtoHours(req.CoolingOffHours),
+		InheritCoolingOff: req.GetInheritCoolingOff(),
+	}
+}
@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"net"
+
+	"github.com/zhunismp/intent-products-api/internal/adapters/primary/grpc/interceptor"
+	pb "github.com/zhunismp/intent-products-api/internal/adapters/primary/grpc/pb/product/v1"
+	"github.com/zhunismp/intent-products-api/internal/adapters/primary/grpc/product"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/apikey"
+	core "github.com/zhunismp/intent-products-api/internal/core/infrastructure/config"
+	"github.com/zhunismp/intent-products-api/internal/core/infrastructure/token"
+	gogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type GrpcServer struct {
+	cfg          core.AppConfigProvider
+	log          *slog.Logger
+	server       *gogrpc.Server
+	healthServer *health.Server
+}
+
+type ServiceGroup struct {
+	product *product.ProductGrpcHandler
+}
+
+func NewServiceGroup(product *product.ProductGrpcHandler) *ServiceGroup {
+	return &ServiceGroup{product: product}
+}
+
+func NewGrpcServer(cfg core.AppConfigProvider, slogLogger *slog.Logger, verifier token.Verifier, apiKeys apikey.ApiKeyUsecase) *GrpcServer {
+	if cfg == nil {
+		log.Fatal("server configuration is missing for gRPC server initialization")
+	}
+	if slogLogger == nil {
+		log.Fatal("logger instance is missing for gRPC server initialization")
+	}
+
+	server := gogrpc.NewServer(
+		gogrpc.ChainUnaryInterceptor(
+			interceptor.RecoveryInterceptor(slogLogger),
+			interceptor.RequestIDInterceptor(),
+			interceptor.TraceInterceptor(),
+			interceptor.AccessLogInterceptor(slogLogger),
+			interceptor.ErrorInterceptor(),
+			interceptor.AuthInterceptor(verifier, apiKeys, cfg.GetAuthLegacyHeaderEnabled()),
+		),
+	)
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	slogLogger.Info("gRPC server core initialized with interceptors.")
+
+	return &GrpcServer{
+		cfg:          cfg,
+		log:          slogLogger,
+		server:       server,
+		healthServer: healthServer,
+	}
+}
+
+// RegisterServices refuses a group without a product handler, serving nil would panic on
+// the first call instead of at startup.
+func (s *GrpcServer) RegisterServices(serviceGroup *ServiceGroup) error {
+	if serviceGroup.product == nil {
+		return errors.New("no product service handler to register")
+	}
+
+	pb.RegisterProductServiceServer(s.server, serviceGroup.product)
+	s.healthServer.SetServingStatus(pb.ProductService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
+	s.log.Info("registered gRPC service", slog.String("service", pb.ProductService_ServiceDesc.ServiceName))
+	return nil
+}
+
+func (s *GrpcServer) Start() {
+	serverAddr := fmt.Sprintf("%s:%s", s.cfg.GetServerHost(), s.cfg.GetGrpcServerPort())
+	s.log.Info("attempting to start gRPC server...", slog.String("address", serverAddr))
+
+	listener, err := net.Listen("tcp", serverAddr)
+	if err != nil {
+		s.log.Error("failed to listen for gRPC server", slog.String("address", serverAddr), slog.Any("error", err))
+		return
+	}
+
+	go func() {
+		if err := s.server.Serve(listener); err != nil {
+			s.log.Error("failed to start gRPC server listener", slog.Any("error", err))
+		}
+	}()
+
+	s.log.Info("gRPC server listener started.", slog.String("address", serverAddr))
+}
+
+func (s *GrpcServer) GracefulShutdown(ctx context.Context) error {
+	s.log.Info("gracefully shutting down gRPC server...")
+
+	s.healthServer.Shutdown()
+
+	done := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.log.Info("gRPC server shutdown gracefully.")
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		s.log.Error("error during gRPC server shutdown")
+		return ctx.Err()
+	}
+}
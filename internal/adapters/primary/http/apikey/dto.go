@@ -0,0 +1,14 @@
+package apikey
+
+import core "github.com/zhunismp/intent-products-api/internal/core/domain/apikey"
+
+type CreateApiKeyRequest struct {
+	Name   string   `json:"name" validate:"required,max=100"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,oneof=read write"`
+}
+
+// CreateApiKeyResponse is the only time the raw key is returned.
+type CreateApiKeyResponse struct {
+	*core.ApiKey
+	Key string `json:"key"`
+}
@@ -0,0 +1,113 @@
+package apikey
+
+import (
+	"log/slog"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v3"
+	dto "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/shared/dto"
+	core "github.com/zhunismp/intent-products-api/internal/core/domain/apikey"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/auth"
+)
+
+type ApiKeyHttpHandler struct {
+	apiKeySvc    core.ApiKeyUsecase
+	reqValidator *validator.Validate
+	logger       *slog.Logger
+}
+
+func NewApiKeyHttpHandler(apiKeySvc core.ApiKeyUsecase, logger *slog.Logger) *ApiKeyHttpHandler {
+	return &ApiKeyHttpHandler{
+		apiKeySvc:    apiKeySvc,
+		reqValidator: validator.New(),
+		logger:       logger,
+	}
+}
+
+func (h *ApiKeyHttpHandler) CreateApiKey(c fiber.Ctx) error {
+	ownerID, err := getSessionUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	req := new(CreateApiKeyRequest)
+
+	// parse request body
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse request body"})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	// calling svc
+	key, rawKey, err := h.apiKeySvc.CreateKey(c.Context(), ownerID, req.Name, req.Scopes)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusCreated, "api key was created successfully, store the key now as it can not be shown again", CreateApiKeyResponse{
+		ApiKey: key,
+		Key:    rawKey,
+	})
+}
+
+func (h *ApiKeyHttpHandler) ListApiKeys(c fiber.Ctx) error {
+	ownerID, err := getSessionUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	// calling svc
+	keys, err := h.apiKeySvc.ListKeys(c.Context(), ownerID)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "get api keys successfully", keys)
+}
+
+func (h *ApiKeyHttpHandler) RevokeApiKey(c fiber.Ctx) error {
+	ownerID, err := getSessionUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	id, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	// calling svc
+	if err := h.apiKeySvc.RevokeKey(c.Context(), ownerID, uint(id)); err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "api key was revoked", id)
+}
+
+// getSessionUserId also keeps api keys from managing keys, so a read-only key can not mint a write key.
+func getSessionUserId(c fiber.Ctx) (uint, error) {
+	userID, err := dto.GetUserId(c)
+	if err != nil {
+		return 0, err
+	}
+
+	if principal, _ := auth.FromContext(c.Context()); principal.Method == auth.MethodAPIKey {
+		return 0, apperrors.New(apperrors.ErrCodeForbidden, "api keys can not be managed with an api key", nil)
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,58 @@
+package docs
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/zhunismp/intent-products-api/api"
+)
+
+// docsPage pins the swagger-ui version it loads, bump it deliberately
+//
+//go:embed index.html
+var docsPage []byte
+
+// LoadSpec parses and validates the embedded OpenAPI document.
+func LoadSpec() (*openapi3.T, error) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(api.OpenAPISpec)
+	if err != nil {
+		return nil, fmt.Errorf("load openapi spec: %w", err)
+	}
+
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("validate openapi spec: %w", err)
+	}
+
+	return doc, nil
+}
+
+// DocsHandler serves the OpenAPI document as JSON and a docs page rendering it. Only the page
+// ships with the binary, the swagger-ui assets it renders with come from the unpkg CDN, so
+// reading the docs needs a browser that can reach it.
+type DocsHandler struct {
+	specJSON []byte
+}
+
+func NewDocsHandler(doc *openapi3.T) (*DocsHandler, error) {
+	specJSON, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("marshal openapi spec: %w", err)
+	}
+
+	return &DocsHandler{specJSON: specJSON}, nil
+}
+
+func (h *DocsHandler) GetSpec(c fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+	return c.Send(h.specJSON)
+}
+
+func (h *DocsHandler) GetDocs(c fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	return c.Send(docsPage)
+}
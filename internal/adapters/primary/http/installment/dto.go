@@ -0,0 +1,13 @@
+package installment
+
+type CreatePlanRequest struct {
+	Total        float64 `json:"total" validate:"min=0"`
+	Months       int     `json:"months" validate:"required,min=1,max=120"`
+	StartDate    string  `json:"startDate" validate:"required,datetime=2006-01-02"`
+	InterestRate float64 `json:"interestRate" validate:"min=0,max=100"`
+}
+
+type RecordPaymentRequest struct {
+	Amount float64 `json:"amount" validate:"gt=0"`
+	PaidAt string  `json:"paidAt" validate:"omitempty,datetime=2006-01-02"`
+}
@@ -0,0 +1,186 @@
+package installment
+
+import (
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v3"
+	dto "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/shared/dto"
+	core "github.com/zhunismp/intent-products-api/internal/core/domain/installment"
+)
+
+const dateLayout = "2006-01-02"
+
+type InstallmentHttpHandler struct {
+	installmentSvc core.InstallmentUsecase
+	reqValidator   *validator.Validate
+	logger         *slog.Logger
+}
+
+func NewInstallmentHttpHandler(installmentSvc core.InstallmentUsecase, logger *slog.Logger) *InstallmentHttpHandler {
+	return &InstallmentHttpHandler{
+		installmentSvc: installmentSvc,
+		reqValidator:   validator.New(),
+		logger:         logger,
+	}
+}
+
+func (h *InstallmentHttpHandler) CreatePlan(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	productID, err := getProductId(c)
+	if err != nil {
+		return err
+	}
+
+	req := new(CreatePlanRequest)
+
+	// parse request body
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse request body"})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	// already validated by the datetime rule
+	startDate, _ := time.Parse(dateLayout, req.StartDate)
+
+	// calling svc
+	plan, err := h.installmentSvc.CreatePlan(c.Context(), ownerID, productID, req.Total, req.Months, startDate, req.InterestRate)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusCreated, "installment plan was created successfully", plan)
+}
+
+func (h *InstallmentHttpHandler) GetPlan(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	productID, err := getProductId(c)
+	if err != nil {
+		return err
+	}
+
+	// calling svc
+	plan, err := h.installmentSvc.GetPlan(c.Context(), ownerID, productID)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "get installment plan successfully", plan)
+}
+
+func (h *InstallmentHttpHandler) GetSchedule(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	productID, err := getProductId(c)
+	if err != nil {
+		return err
+	}
+
+	// calling svc
+	schedule, err := h.installmentSvc.GetSchedule(c.Context(), ownerID, productID)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "get amortization schedule successfully", schedule)
+}
+
+func (h *InstallmentHttpHandler) RecordPayment(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	productID, err := getProductId(c)
+	if err != nil {
+		return err
+	}
+
+	req := new(RecordPaymentRequest)
+
+	// parse request body
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse request body"})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	// payment defaults to today
+	paidAt := time.Now()
+	if req.PaidAt != "" {
+		paidAt, _ = time.Parse(dateLayout, req.PaidAt)
+	}
+
+	// calling svc
+	plan, err := h.installmentSvc.RecordPayment(c.Context(), ownerID, productID, req.Amount, paidAt)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusCreated, "installment payment was recorded successfully", plan)
+}
+
+func (h *InstallmentHttpHandler) CancelPlan(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	productID, err := getProductId(c)
+	if err != nil {
+		return err
+	}
+
+	// calling svc
+	product, err := h.installmentSvc.CancelPlan(c.Context(), ownerID, productID)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "installment plan was cancelled successfully", product)
+}
+
+func getProductId(c fiber.Ctx) (uint, error) {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return 0, c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	return uint(id), nil
+}
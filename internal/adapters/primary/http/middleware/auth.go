@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	slogctx "github.com/veqryn/slog-context"
+	"github.com/zhunismp/intent-products-api/internal/adapters/primary/http/shared/dto"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/apikey"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/auth"
+	"github.com/zhunismp/intent-products-api/internal/core/infrastructure/token"
+)
+
+const (
+	apiKeyHeader       = "X-API-Key"
+	legacyUserIDHeader = "X-User-Id"
+)
+
+// AuthMiddleware authenticates the caller from a bearer token or an API key and puts
+// the principal into the request context. When legacyHeader is set, a request without
+// either may identify its user with the X-User-Id header instead, which is only meant
+// for trusted internal callers. API keys are held to their scopes, reads need the read
+// scope and everything else the write scope.
+func AuthMiddleware(verifier token.Verifier, apiKeys apikey.ApiKeyUsecase, legacyHeader bool) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		var principal *auth.Principal
+
+		if bearer, ok := bearerToken(c.Get(fiber.HeaderAuthorization)); ok {
+			p, err := verifier.Verify(c.Context(), bearer)
+			if err != nil {
+				return unauthorized(c, err)
+			}
+			principal = p
+		} else if key := c.Get(apiKeyHeader); key != "" {
+			p, err := apiKeys.Authenticate(c.Context(), key)
+			if err != nil {
+				return dto.HandleError(c, err)
+			}
+			principal = p
+		} else if userID := c.Get(legacyUserIDHeader); legacyHeader && userID != "" {
+			id, err := strconv.ParseUint(userID, 10, 64)
+			if err != nil || id == 0 {
+				return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{ErrorMessage: "X-User-Id is invalid"})
+			}
+			principal = &auth.Principal{
+				UserID:  uint(id),
+				Subject: userID,
+				Method:  auth.MethodLegacyHeader,
+			}
+		} else {
+			c.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+			return c.Status(fiber.StatusUnauthorized).JSON(dto.ErrorResponse{ErrorMessage: "missing bearer token or api key"})
+		}
+
+		scope := requiredScope(c.Method())
+		if !principal.Allows(scope) {
+			return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{ErrorMessage: fmt.Sprintf("api key lacks the %s scope", scope)})
+		}
+
+		ctx := slogctx.Append(c.Context(),
+			"user_id", principal.UserID,
+		)
+		c.SetContext(auth.NewContext(ctx, principal))
+
+		return c.Next()
+	}
+}
+
+func bearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+func requiredScope(method string) string {
+	switch method {
+	case fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions:
+		return auth.ScopeRead
+	default:
+		return auth.ScopeWrite
+	}
+}
+
+func unauthorized(c fiber.Ctx, err error) error {
+	c.Set(fiber.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+	return dto.HandleError(c, err)
+}
@@ -0,0 +1,155 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/adaptor"
+	"github.com/zhunismp/intent-products-api/internal/adapters/primary/http/shared/dto"
+)
+
+// OpenAPIValidationMiddleware validates requests against the OpenAPI document before
+// they reach the handlers, and the responses the handlers produce on the way out.
+// Paths of the document are relative to basePath.
+//
+// A request that does not match the document is rejected with 400, a response that
+// does not match is logged and replaced with 500 so drift between the spec and the
+// handlers surfaces immediately.
+func OpenAPIValidationMiddleware(doc *openapi3.T, basePath string, log *slog.Logger) (fiber.Handler, error) {
+	// the document describes paths relative to its server, the prefix is trimmed
+	// before routing so the configured base path does not have to match it
+	routed := *doc
+	routed.Servers = nil
+
+	router, err := legacy.NewRouter(&routed)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &openapi3filter.Options{
+		IncludeResponseStatus: true,
+		MultiError:            true,
+		AuthenticationFunc:    openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(c fiber.Ctx) error {
+		req, err := adaptor.ConvertRequest(c, false)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+		}
+		req.URL.Path = strings.TrimPrefix(req.URL.Path, basePath)
+
+		route, pathParams, err := router.FindRoute(req)
+		if err != nil {
+			// unknown routes are left to the router to answer
+			var routeErr *routers.RouteError
+			if errors.As(err, &routeErr) {
+				return c.Next()
+			}
+			return err
+		}
+
+		reqInput := &openapi3filter.RequestValidationInput{
+			Request:    req,
+			PathParams: pathParams,
+			Route:      route,
+			Options:    options,
+		}
+
+		if err := openapi3filter.ValidateRequest(c.Context(), reqInput); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  openAPIErrorMap(err),
+			})
+		}
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		header := make(http.Header)
+		for key, value := range c.Response().Header.All() {
+			header.Add(string(key), string(value))
+		}
+
+		resInput := &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: reqInput,
+			Status:                 c.Response().StatusCode(),
+			Header:                 header,
+			Body:                   io.NopCloser(bytes.NewReader(c.Response().Body())),
+			Options:                options,
+		}
+
+		if err := openapi3filter.ValidateResponse(c.Context(), resInput); err != nil {
+			log.ErrorContext(c.Context(), "response does not match the openapi spec",
+				slog.String("method", c.Method()),
+				slog.String("path", c.Path()),
+				slog.Int("status", c.Response().StatusCode()),
+				slog.Any("error", err),
+			)
+
+			c.Response().ResetBody()
+			return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+		}
+
+		return nil
+	}, nil
+}
+
+func openAPIErrorMap(err error) map[string]string {
+	errMap := make(map[string]string)
+	collectOpenAPIErrors(err, errMap)
+	return errMap
+}
+
+// collectOpenAPIErrors keeps the reason of each error only, the full errors echo the
+// schema and the rejected value back to the client.
+func collectOpenAPIErrors(err error, errMap map[string]string) {
+	var multi openapi3.MultiError
+	if errors.As(err, &multi) {
+		for _, e := range multi {
+			collectOpenAPIErrors(e, errMap)
+		}
+		return
+	}
+
+	var paramErr *openapi3filter.RequestError
+	var schemaErr *openapi3.SchemaError
+
+	switch {
+	case errors.As(err, &paramErr) && paramErr.Parameter != nil:
+		errMap[paramErr.Parameter.Name] = requestErrorReason(paramErr)
+	case errors.As(err, &schemaErr):
+		field := strings.Join(schemaErr.JSONPointer(), ".")
+		if field == "" {
+			field = "body"
+		}
+		errMap[field] = schemaErr.Reason
+	case errors.As(err, &paramErr):
+		errMap["request"] = requestErrorReason(paramErr)
+	default:
+		errMap["request"] = err.Error()
+	}
+}
+
+func requestErrorReason(err *openapi3filter.RequestError) string {
+	var schemaErr *openapi3.SchemaError
+	switch {
+	case errors.As(err.Err, &schemaErr):
+		return schemaErr.Reason
+	case err.Reason != "":
+		return err.Reason
+	case err.Err != nil:
+		return err.Err.Error()
+	}
+	return "invalid value"
+}
@@ -1,24 +1,125 @@
 package product
 
+import "time"
+
+// CreateProductRequest follows the owner's default cooling-off unless coolingOffHours is set.
 type CreateProductRequest struct {
+	ListID  uint     `json:"listId" validate:"omitempty,min=1"`
 	Title   string   `json:"title" validate:"required"`
 	Price   float64  `json:"price" validate:"min=1"`
 	Link    string   `json:"link" validate:"omitempty,url"`
 	Reasons []string `json:"reasons" validate:"omitempty,dive,required"`
+
+	CoolingOffHours *int `json:"coolingOffHours" validate:"omitnil,min=0,max=8760"`
 }
 
+// UpdatePriorityRequest moves a product by mode. Without a mode it keeps the original
+// behaviour, after productIdAfter when set and to the top otherwise. listId moves the
+// product into another list for top, bottom and index.
 type UpdatePriorityRequest struct {
-	ProductID      uint  `json:"productId" validate:"required"`
-	ProductIDAfter *uint `json:"productIdAfter"`
+	ProductID      uint   `json:"productId" validate:"required"`
+	ListID         *uint  `json:"listId" validate:"omitnil,min=1"`
+	ProductIDAfter *uint  `json:"productIdAfter"`
+	Mode           string `json:"mode" validate:"omitempty,oneof=before after top bottom index"`
+	TargetID       *uint  `json:"targetId" validate:"omitnil,min=1"`
+	Index          *int   `json:"index" validate:"omitnil,min=0"`
+}
+
+// ReorderProductsRequest lists every product of a list in the wanted order, the owner's
+// default list when listId is omitted.
+type ReorderProductsRequest struct {
+	ListID     uint   `json:"listId" validate:"omitempty,min=1"`
+	ProductIDs []uint `json:"productIds" validate:"required,min=1,dive,min=1"`
+}
+
+// TransitionStatusRequest may override the cooling-off to buy a product early, the
+// justification is kept on the product as a cause.
+type TransitionStatusRequest struct {
+	Status   string                     `json:"status" validate:"required,oneof=pending installment bought"`
+	Override *CoolingOffOverrideRequest `json:"override" validate:"omitnil"`
 }
 
+type CoolingOffOverrideRequest struct {
+	Justification string `json:"justification" validate:"required,max=1000"`
+}
+
+// CoolingOffErrorResponse refuses a purchase during the cooling-off with the time left.
+type CoolingOffErrorResponse struct {
+	ErrorMessage     string    `json:"errorMessage"`
+	CoolingOffEndsAt time.Time `json:"coolingOffEndsAt"`
+	RemainingSeconds int64     `json:"remainingSeconds"`
+}
+
+// GetAllProductsRequest pages by offset with page and size, or by cursor when the
+// cursor parameter is present. An empty cursor asks for the first page. Statuses may be
+// repeated or comma separated, so may tag ids, time ranges are RFC 3339 and inclusive.
 type GetAllProductsRequest struct {
-	Status string `query:"status" validate:"omitempty,oneof=pending installment bought"`
-	Page   int    `query:"page" validate:"omitempty,min=1"`
-	Size   int    `query:"size" validate:"omitempty,min=1"`
+	ListID          *uint    `query:"listId" validate:"omitnil,min=1"`
+	TagID           []uint   `query:"tagId" validate:"omitempty,dive,min=1"`
+	Status          []string `query:"status" validate:"omitempty,dive,oneof=pending installment bought"`
+	MinPrice        *float64 `query:"minPrice" validate:"omitnil,min=0"`
+	MaxPrice        *float64 `query:"maxPrice" validate:"omitnil,min=0"`
+	CreatedFrom     string   `query:"createdFrom" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	CreatedTo       string   `query:"createdTo" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	UpdatedFrom     string   `query:"updatedFrom" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	UpdatedTo       string   `query:"updatedTo" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Name            string   `query:"name" validate:"omitempty,max=255"`
+	HasActiveCauses *bool    `query:"hasActiveCauses"`
+	Sort            string   `query:"sort" validate:"omitempty,oneof=position price createdAt name confidence"`
+	Order           string   `query:"order" validate:"omitempty,oneof=asc desc"`
+	Page            int      `query:"page" validate:"omitempty,min=1"`
+	Size            int      `query:"size" validate:"omitempty,min=1"`
+	Cursor          string   `query:"cursor"`
+}
+
+// UpdateCauseRequest only changes the fields that are present, status false marks a
+// reason that no longer applies.
+type UpdateCauseRequest struct {
+	Reason   *string `json:"reason" validate:"omitnil,min=1"`
+	Status   *bool   `json:"status"`
+	Polarity *string `json:"polarity" validate:"omitnil,oneof=pro con"`
+	Weight   *int    `json:"weight" validate:"omitnil,min=1,max=5"`
 }
 
+// ReorderCausesRequest lists every cause of the product in the wanted order.
+type ReorderCausesRequest struct {
+	CauseIDs []uint `json:"causeIds" validate:"required,min=1,dive,min=1"`
+}
+
+type TagProductRequest struct {
+	TagIDs []uint `json:"tagIds" validate:"required,min=1,dive,min=1"`
+}
+
+// CreateCausesRequest adds reasons sharing one polarity and weight, pros of weight 3
+// unless told otherwise.
 type CreateCausesRequest struct {
-	ProductID int `json:"productId" validate:"required,min=1"`
-	Reasons []string `json:"reasons" validate:"required,min=1"`
+	ProductID int      `json:"productId" validate:"required,min=1"`
+	Reasons   []string `json:"reasons" validate:"required,min=1"`
+	Polarity  string   `json:"polarity" validate:"omitempty,oneof=pro con"`
+	Weight    int      `json:"weight" validate:"omitempty,min=1,max=5"`
+}
+
+// UpdateProductRequest is a JSON Merge Patch (RFC 7396) document for a product.
+// Members that are absent are left untouched, link and imageUrl set to null are removed.
+type UpdateProductRequest struct {
+	Name     *string  `json:"name" validate:"omitnil,min=1"`
+	Price    *float64 `json:"price" validate:"omitnil,min=1"`
+	Link     *string  `json:"link" validate:"omitnil,url"`
+	ImageUrl *string  `json:"imageUrl" validate:"omitnil,url"`
+	Status   *string  `json:"status" validate:"omitnil,oneof=pending installment bought"`
+
+	// CoolingOffHours set to null follows the owner's default again
+	CoolingOffHours *int `json:"coolingOffHours" validate:"omitnil,min=0,max=8760"`
+
+	removeLink        bool
+	removeImageUrl    bool
+	inheritCoolingOff bool
+}
+
+type RebalancePositionsResponse struct {
+	Moved int `json:"moved"`
+}
+
+type ReorderProductsResponse struct {
+	Moved int `json:"moved"`
 }
@@ -0,0 +1,55 @@
+package product
+
+import (
+	"strings"
+	"time"
+
+	core "github.com/zhunismp/intent-products-api/internal/core/domain/product"
+)
+
+// splitStatuses accepts status=a&status=b as well as status=a,b.
+func (r *GetAllProductsRequest) splitStatuses() {
+	var statuses []string
+	for _, value := range r.Status {
+		for _, status := range strings.Split(value, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				statuses = append(statuses, status)
+			}
+		}
+	}
+	r.Status = statuses
+}
+
+// toFilter expects a validated request, so the time values are known to parse.
+func (r *GetAllProductsRequest) toFilter() *core.Filter {
+	return &core.Filter{
+		ListID:          r.ListID,
+		TagIDs:          r.TagID,
+		Statuses:        r.Status,
+		MinPrice:        r.MinPrice,
+		MaxPrice:        r.MaxPrice,
+		CreatedFrom:     parseTime(r.CreatedFrom),
+		CreatedTo:       parseTime(r.CreatedTo),
+		UpdatedFrom:     parseTime(r.UpdatedFrom),
+		UpdatedTo:       parseTime(r.UpdatedTo),
+		Name:            r.Name,
+		HasActiveCauses: r.HasActiveCauses,
+		SortBy:          r.Sort,
+		SortDesc:        r.Order == "desc",
+		Page:            r.Page,
+		Size:            r.Size,
+	}
+}
+
+func parseTime(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+
+	return &t
+}
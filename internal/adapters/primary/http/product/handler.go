@@ -1,13 +1,20 @@
 package product
 
 import (
+	"errors"
+	"fmt"
 	"log/slog"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v3"
 	dto "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/shared/dto"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/cause"
 	core "github.com/zhunismp/intent-products-api/internal/core/domain/product"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
 )
 
 type ProductHttpHandler struct {
@@ -29,9 +36,9 @@ func NewProductHttpHandler(productSvc core.ProductUsecase, logger *slog.Logger)
 }
 
 func (h *ProductHttpHandler) CreateProduct(c fiber.Ctx) error {
-	ownerID, err := getUserId(c)
+	ownerID, err := dto.GetUserId(c)
 	if err != nil {
-		return err
+		return dto.HandleError(c, err)
 	}
 
 	req := new(CreateProductRequest)
@@ -44,7 +51,7 @@ func (h *ProductHttpHandler) CreateProduct(c fiber.Ctx) error {
 	// validate req
 	if err := h.reqValidator.Struct(req); err != nil {
 		if errs, ok := err.(validator.ValidationErrors); ok {
-			errMap := GenerateErrorMap(errs)
+			errMap := dto.GenerateErrorMap(errs)
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
 				ErrorMessage: "invalid request",
 				ErrorFields:  errMap,
@@ -55,7 +62,7 @@ func (h *ProductHttpHandler) CreateProduct(c fiber.Ctx) error {
 	}
 
 	// calling svc
-	if err := h.productSvc.CreateProduct(c.Context(), uint(ownerID), req.Title, req.Price, req.Link, req.Reasons); err != nil {
+	if err := h.productSvc.CreateProduct(c.Context(), uint(ownerID), req.ListID, req.Title, req.Price, req.Link, req.Reasons, req.CoolingOffHours); err != nil {
 		return dto.HandleError(c, err)
 	}
 
@@ -66,9 +73,9 @@ func (h *ProductHttpHandler) CreateProduct(c fiber.Ctx) error {
 }
 
 func (h *ProductHttpHandler) GetProduct(c fiber.Ctx) error {
-	ownerID, err := getUserId(c)
+	ownerID, err := dto.GetUserId(c)
 	if err != nil {
-		return err
+		return dto.HandleError(c, err)
 	}
 
 	idStr := c.Params("id")
@@ -90,9 +97,9 @@ func (h *ProductHttpHandler) GetProduct(c fiber.Ctx) error {
 }
 
 func (h *ProductHttpHandler) GetAllProducts(c fiber.Ctx) error {
-	ownerID, err := getUserId(c)
+	ownerID, err := dto.GetUserId(c)
 	if err != nil {
-		return err
+		return dto.HandleError(c, err)
 	}
 
 	// default page and size
@@ -104,10 +111,11 @@ func (h *ProductHttpHandler) GetAllProducts(c fiber.Ctx) error {
 	if err := c.Bind().Query(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse request body"})
 	}
+	req.splitStatuses()
 
 	if err := h.reqValidator.Struct(req); err != nil {
 		if errs, ok := err.(validator.ValidationErrors); ok {
-			errMap := GenerateErrorMap(errs)
+			errMap := dto.GenerateErrorMap(errs)
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
 				ErrorMessage: "invalid request",
 				ErrorFields:  errMap,
@@ -117,14 +125,12 @@ func (h *ProductHttpHandler) GetAllProducts(c fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
 	}
 
-	filter := &core.Filter{
-		Page:   req.Page,
-		Size:   req.Size,
-		Status: req.Status,
+	if c.Request().URI().QueryArgs().Has("cursor") {
+		return h.getProductsPage(c, ownerID, &req)
 	}
 
 	// calling svc
-	products, err := h.productSvc.GetAllProducts(c.Context(), ownerID, filter)
+	products, err := h.productSvc.GetAllProducts(c.Context(), ownerID, req.toFilter())
 	if err != nil {
 		return dto.HandleError(c, err)
 	}
@@ -132,10 +138,175 @@ func (h *ProductHttpHandler) GetAllProducts(c fiber.Ctx) error {
 	return dto.HandleResponse(c, fiber.StatusOK, "get product successfully", products)
 }
 
+func (h *ProductHttpHandler) GetSummary(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	// calling svc
+	summary, err := h.productSvc.GetSummary(c.Context(), ownerID)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "get product summary successfully", summary)
+}
+
+func (h *ProductHttpHandler) getProductsPage(c fiber.Ctx, ownerID uint, req *GetAllProductsRequest) error {
+	filter := &core.CursorFilter{
+		Filter: *req.toFilter(),
+		Cursor: req.Cursor,
+	}
+
+	// calling svc
+	page, err := h.productSvc.GetProductsPage(c.Context(), ownerID, filter)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	var links []string
+	if page.NextCursor != "" {
+		links = append(links, pageLink(c, page.NextCursor, "next"))
+	}
+	if page.PrevCursor != "" {
+		links = append(links, pageLink(c, page.PrevCursor, "prev"))
+	}
+	if len(links) > 0 {
+		c.Set(fiber.HeaderLink, strings.Join(links, ", "))
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "get product successfully", page)
+}
+
+func (h *ProductHttpHandler) UpdateProduct(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	idStr := c.Params("id")
+
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	productID := uint(id)
+
+	req := new(UpdateProductRequest)
+
+	// parse merge patch body
+	errMap, err := parseMergePatch(c.Body(), req)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse request body"})
+	}
+	if len(errMap) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+			ErrorMessage: "invalid request",
+			ErrorFields:  errMap,
+		})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	// calling svc
+	product, err := h.productSvc.UpdateProduct(c.Context(), ownerID, productID, req.toPatch())
+	if err != nil {
+		return handleCoolingOffError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "product was updated successfully", product)
+}
+
+func (h *ProductHttpHandler) TransitionStatus(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	idStr := c.Params("id")
+
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	productID := uint(id)
+
+	req := new(TransitionStatusRequest)
+
+	// parse request body
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse request body"})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	var override *core.Override
+	if req.Override != nil {
+		override = &core.Override{Justification: req.Override.Justification}
+	}
+
+	// calling svc
+	product, err := h.productSvc.TransitionStatus(c.Context(), ownerID, productID, req.Status, override)
+	if err != nil {
+		return handleCoolingOffError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "product status was changed successfully", product)
+}
+
+func (h *ProductHttpHandler) GetStatusHistory(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	idStr := c.Params("id")
+
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	productID := uint(id)
+
+	// calling svc
+	changes, err := h.productSvc.GetStatusHistory(c.Context(), ownerID, productID)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "get status history successfully", changes)
+}
+
 func (h *ProductHttpHandler) MoveProductPosition(c fiber.Ctx) error {
-	ownerID, err := getUserId(c)
+	ownerID, err := dto.GetUserId(c)
 	if err != nil {
-		return err
+		return dto.HandleError(c, err)
 	}
 
 	req := new(UpdatePriorityRequest)
@@ -148,7 +319,7 @@ func (h *ProductHttpHandler) MoveProductPosition(c fiber.Ctx) error {
 	// validate req
 	if err := h.reqValidator.Struct(req); err != nil {
 		if errs, ok := err.(validator.ValidationErrors); ok {
-			errMap := GenerateErrorMap(errs)
+			errMap := dto.GenerateErrorMap(errs)
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
 				ErrorMessage: "invalid request",
 				ErrorFields:  errMap,
@@ -158,17 +329,65 @@ func (h *ProductHttpHandler) MoveProductPosition(c fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
 	}
 
-	if err := h.productSvc.Move(c.Context(), ownerID, req.ProductID, req.ProductIDAfter); err != nil {
+	if err := h.productSvc.Move(c.Context(), ownerID, req.toMoveCommand()); err != nil {
 		return dto.HandleError(c, err)
 	}
 
 	return dto.HandleResponse(c, fiber.StatusOK, "priority was updated successfully", nil)
 }
 
+func (h *ProductHttpHandler) ReorderProducts(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	req := new(ReorderProductsRequest)
+
+	// parse request body
+	if err := c.Bind().Body(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse request body"})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	moved, err := h.productSvc.Reorder(c.Context(), ownerID, req.ListID, req.ProductIDs)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "products were reordered successfully", ReorderProductsResponse{Moved: moved})
+}
+
+func (h *ProductHttpHandler) RebalancePositions(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	moved, err := h.productSvc.RebalancePositions(c.Context(), ownerID)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "positions were rebalanced successfully", RebalancePositionsResponse{Moved: moved})
+}
+
 func (h *ProductHttpHandler) DeleteProduct(c fiber.Ctx) error {
-	ownerID, err := getUserId(c)
+	ownerID, err := dto.GetUserId(c)
 	if err != nil {
-		return err
+		return dto.HandleError(c, err)
 	}
 
 	idStr := c.Params("id")
@@ -189,9 +408,9 @@ func (h *ProductHttpHandler) DeleteProduct(c fiber.Ctx) error {
 }
 
 func (h *ProductHttpHandler) CreateCauses(c fiber.Ctx) error {
-	ownerID, err := getUserId(c)
+	ownerID, err := dto.GetUserId(c)
 	if err != nil {
-		return err
+		return dto.HandleError(c, err)
 	}
 
 	req := new(CreateCausesRequest)
@@ -204,7 +423,7 @@ func (h *ProductHttpHandler) CreateCauses(c fiber.Ctx) error {
 	// validate req
 	if err := h.reqValidator.Struct(req); err != nil {
 		if errs, ok := err.(validator.ValidationErrors); ok {
-			errMap := GenerateErrorMap(errs)
+			errMap := dto.GenerateErrorMap(errs)
 			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
 				ErrorMessage: "invalid request",
 				ErrorFields:  errMap,
@@ -215,18 +434,214 @@ func (h *ProductHttpHandler) CreateCauses(c fiber.Ctx) error {
 	}
 
 	// calling svc
-	if err := h.productSvc.AddCauses(c.Context(), ownerID, uint(req.ProductID), req.Reasons); err != nil {
+	if err := h.productSvc.AddCauses(c.Context(), ownerID, uint(req.ProductID), req.Reasons, req.Polarity, req.Weight); err != nil {
 		return dto.HandleError(c, err)
 	}
 
 	return dto.HandleResponse(c, fiber.StatusOK, "causes was added successfully", nil)
 }
 
-func getUserId(c fiber.Ctx) (uint, error) {
-	ownerID, err := strconv.ParseUint(c.Get("X-User-Id"), 10, 64)
-	if err != nil || ownerID <= 0 {
-		return 0, c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "X-User-Id is invalid"})
+func (h *ProductHttpHandler) UpdateCause(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	id, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	causeID, err := strconv.ParseUint(c.Params("causeId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse cause id"})
+	}
+
+	req := new(UpdateCauseRequest)
+
+	// parse request body
+	if err := c.Bind().Body(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse request body"})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	// calling svc
+	updated, err := h.productSvc.UpdateCause(c.Context(), ownerID, uint(id), uint(causeID), &cause.CausePatch{
+		Reason:   req.Reason,
+		Status:   req.Status,
+		Polarity: req.Polarity,
+		Weight:   req.Weight,
+	})
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "cause was updated successfully", updated)
+}
+
+func (h *ProductHttpHandler) DeleteCause(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	id, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	causeID, err := strconv.ParseUint(c.Params("causeId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse cause id"})
+	}
+
+	// calling svc
+	if err := h.productSvc.DeleteCause(c.Context(), ownerID, uint(id), uint(causeID)); err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "cause was deleted successfully", causeID)
+}
+
+func (h *ProductHttpHandler) ReorderCauses(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
 	}
 
-	return uint(ownerID), nil
+	id, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	req := new(ReorderCausesRequest)
+
+	// parse request body
+	if err := c.Bind().Body(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse request body"})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	// calling svc
+	causes, err := h.productSvc.ReorderCauses(c.Context(), ownerID, uint(id), req.CauseIDs)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "causes were reordered successfully", causes)
+}
+
+func (h *ProductHttpHandler) TagProduct(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	id, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	req := new(TagProductRequest)
+
+	// parse request body
+	if err := c.Bind().Body(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse request body"})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	// calling svc
+	tags, err := h.productSvc.TagProduct(c.Context(), ownerID, uint(id), req.TagIDs)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "product was tagged successfully", tags)
+}
+
+func (h *ProductHttpHandler) UntagProduct(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	id, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	tagID, err := strconv.ParseUint(c.Params("tagId"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse tag id"})
+	}
+
+	// calling svc
+	if err := h.productSvc.UntagProduct(c.Context(), ownerID, uint(id), uint(tagID)); err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "product was untagged successfully", tagID)
+}
+
+// pageLink builds an RFC 8288 link to the same listing with another cursor.
+func pageLink(c fiber.Ctx, cursor string, rel string) string {
+	query, _ := url.ParseQuery(string(c.Request().URI().QueryString()))
+	query.Set("cursor", cursor)
+
+	return fmt.Sprintf(`<%s%s?%s>; rel="%s"`, c.BaseURL(), c.Path(), query.Encode(), rel)
+}
+
+// handleCoolingOffError answers a purchase refused during the cooling-off with the time
+// left, in the body and as Retry-After. Other errors are handled as usual.
+func handleCoolingOffError(c fiber.Ctx, err error) error {
+	var coolingOff *core.CoolingOffError
+	var appErr *apperrors.AppError
+	if !errors.As(err, &coolingOff) || !errors.As(err, &appErr) {
+		return dto.HandleError(c, err)
+	}
+
+	seconds := int64(coolingOff.Remaining / time.Second)
+	c.Set(fiber.HeaderRetryAfter, strconv.FormatInt(seconds, 10))
+
+	return c.Status(apperrors.MapToHttpCode(appErr.Code)).JSON(CoolingOffErrorResponse{
+		ErrorMessage:     appErr.Message,
+		CoolingOffEndsAt: coolingOff.EndsAt,
+		RemainingSeconds: seconds,
+	})
 }
@@ -0,0 +1,74 @@
+package product
+
+import (
+	"encoding/json"
+	"errors"
+
+	core "github.com/zhunismp/intent-products-api/internal/core/domain/product"
+)
+
+var errInvalidMergePatch = errors.New("merge patch must be a JSON object")
+
+// parseMergePatch decodes a JSON Merge Patch document. Optional members set to
+// null are marked for removal, required members set to null are reported in the
+// returned error map.
+func parseMergePatch(body []byte, req *UpdateProductRequest) (map[string]string, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil {
+		return nil, err
+	}
+	if members == nil {
+		return nil, errInvalidMergePatch
+	}
+
+	if err := json.Unmarshal(body, req); err != nil {
+		return nil, err
+	}
+
+	errMap := make(map[string]string)
+	for name, raw := range members {
+		if string(raw) != "null" {
+			continue
+		}
+
+		switch name {
+		case "link":
+			req.removeLink = true
+		case "imageUrl":
+			req.removeImageUrl = true
+		case "coolingOffHours":
+			req.inheritCoolingOff = true
+		case "name":
+			errMap["Name"] = "failed on 'required' rule"
+		case "price":
+			errMap["Price"] = "failed on 'required' rule"
+		case "status":
+			errMap["Status"] = "failed on 'required' rule"
+		}
+	}
+
+	return errMap, nil
+}
+
+func (r *UpdateProductRequest) toPatch() *core.Patch {
+	patch := &core.Patch{
+		Name:     r.Name,
+		Price:    r.Price,
+		Link:     r.Link,
+		ImageUrl: r.ImageUrl,
+		Status:   r.Status,
+
+		CoolingOffHours:   r.CoolingOffHours,
+		InheritCoolingOff: r.inheritCoolingOff,
+	}
+
+	empty := ""
+	if r.removeLink {
+		patch.Link = &empty
+	}
+	if r.removeImageUrl {
+		patch.ImageUrl = &empty
+	}
+
+	return patch
+}
@@ -0,0 +1,29 @@
+package product
+
+import (
+	core "github.com/zhunismp/intent-products-api/internal/core/domain/product"
+)
+
+// toMoveCommand maps the request onto a move command. productIdAfter predates the modes, it
+// still works on its own and stands in for targetId when a mode is given without one.
+func (r *UpdatePriorityRequest) toMoveCommand() *core.MoveCommand {
+	cmd := &core.MoveCommand{
+		ProductID: r.ProductID,
+		Mode:      r.Mode,
+		ListID:    r.ListID,
+		TargetID:  r.TargetID,
+		Index:     r.Index,
+	}
+
+	if cmd.TargetID == nil {
+		cmd.TargetID = r.ProductIDAfter
+	}
+	if cmd.Mode == "" {
+		cmd.Mode = core.MoveTop
+		if r.ProductIDAfter != nil {
+			cmd.Mode = core.MoveAfter
+		}
+	}
+
+	return cmd
+}
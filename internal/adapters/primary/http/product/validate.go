@@ -1,22 +1,11 @@
 package product
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 )
 
-func GenerateErrorMap(errs validator.ValidationErrors) map[string]string {
-	errMap := make(map[string]string)
-
-	for _, e := range errs {
-		errMap[e.Field()] = fmt.Sprintf("failed on '%s' rule", e.Tag())
-	}
-
-	return errMap
-}
-
 // custom validator
 func IsDateAfter(fl validator.FieldLevel) bool {
 	otherField := fl.Parent().FieldByName(fl.Param())
@@ -0,0 +1,6 @@
+package search
+
+type SearchProductsRequest struct {
+	Query string `query:"q" validate:"required,max=200"`
+	Limit int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
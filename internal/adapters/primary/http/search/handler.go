@@ -0,0 +1,59 @@
+package search
+
+import (
+	"log/slog"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v3"
+	dto "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/shared/dto"
+	core "github.com/zhunismp/intent-products-api/internal/core/domain/search"
+)
+
+type SearchHttpHandler struct {
+	searchSvc    core.SearchUsecase
+	reqValidator *validator.Validate
+	logger       *slog.Logger
+}
+
+func NewSearchHttpHandler(searchSvc core.SearchUsecase, logger *slog.Logger) *SearchHttpHandler {
+	return &SearchHttpHandler{
+		searchSvc:    searchSvc,
+		reqValidator: validator.New(),
+		logger:       logger,
+	}
+}
+
+func (h *SearchHttpHandler) SearchProducts(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	req := new(SearchProductsRequest)
+
+	// parse query string
+	if err := c.Bind().Query(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse query parameters"})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	// calling svc
+	results, err := h.searchSvc.SearchProducts(c.Context(), ownerID, req.Query, req.Limit)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "search products successfully", results)
+}
@@ -12,9 +12,19 @@ import (
 	cors "github.com/gofiber/fiber/v3/middleware/cors"
 	limiter "github.com/gofiber/fiber/v3/middleware/limiter"
 	recover "github.com/gofiber/fiber/v3/middleware/recover"
+	httpapikey "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/apikey"
+	"github.com/zhunismp/intent-products-api/internal/adapters/primary/http/docs"
+	"github.com/zhunismp/intent-products-api/internal/adapters/primary/http/installment"
 	"github.com/zhunismp/intent-products-api/internal/adapters/primary/http/middleware"
 	"github.com/zhunismp/intent-products-api/internal/adapters/primary/http/product"
+	"github.com/zhunismp/intent-products-api/internal/adapters/primary/http/search"
+	"github.com/zhunismp/intent-products-api/internal/adapters/primary/http/settings"
+	"github.com/zhunismp/intent-products-api/internal/adapters/primary/http/sharing"
+	"github.com/zhunismp/intent-products-api/internal/adapters/primary/http/tag"
+	"github.com/zhunismp/intent-products-api/internal/adapters/primary/http/wishlist"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/apikey"
 	core "github.com/zhunismp/intent-products-api/internal/core/infrastructure/config"
+	"github.com/zhunismp/intent-products-api/internal/core/infrastructure/token"
 )
 
 type HttpServer struct {
@@ -23,17 +33,41 @@ type HttpServer struct {
 	fiberApp      *fiber.App
 	apiBaseRouter fiber.Router
 	basePath      string
+	docs          *docs.DocsHandler
+	auth          fiber.Handler
 }
 
 type RouteGroup struct {
-	product *product.ProductHttpHandler
+	product     *product.ProductHttpHandler
+	installment *installment.InstallmentHttpHandler
+	apiKey      *httpapikey.ApiKeyHttpHandler
+	search      *search.SearchHttpHandler
+	wishlist    *wishlist.WishlistHttpHandler
+	sharing     *sharing.SharingHttpHandler
+	tag         *tag.TagHttpHandler
+	settings    *settings.SettingsHttpHandler
 }
 
-func NewRouteGroup(product *product.ProductHttpHandler) *RouteGroup {
-	return &RouteGroup{product: product}
+func NewRouteGroup(
+	product *product.ProductHttpHandler,
+	installment *installment.InstallmentHttpHandler,
+	apiKey *httpapikey.ApiKeyHttpHandler,
+	search *search.SearchHttpHandler,
+	wishlist *wishlist.WishlistHttpHandler,
+	sharing *sharing.SharingHttpHandler,
+	tag *tag.TagHttpHandler,
+	settings *settings.SettingsHttpHandler,
+) *RouteGroup {
+	return &RouteGroup{product: product, installment: installment, apiKey: apiKey, search: search, wishlist: wishlist, sharing: sharing, tag: tag, settings: settings}
 }
 
-func NewHttpServer(cfg core.AppConfigProvider, slogLogger *slog.Logger, baseApiPrefix string) *HttpServer {
+func NewHttpServer(
+	cfg core.AppConfigProvider,
+	slogLogger *slog.Logger,
+	baseApiPrefix string,
+	verifier token.Verifier,
+	apiKeys apikey.ApiKeyUsecase,
+) *HttpServer {
 	validateArguments(cfg, slogLogger, &baseApiPrefix)
 
 	app := fiber.New(fiber.Config{
@@ -47,7 +81,7 @@ func NewHttpServer(cfg core.AppConfigProvider, slogLogger *slog.Logger, baseApiP
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
-		AllowHeaders:     []string{"Origin", "X-PINGOTHER", "Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		AllowHeaders:     []string{"Origin", "X-PINGOTHER", "Accept", "Authorization", "X-API-Key", "Content-Type", "X-CSRF-Token"},
 		ExposeHeaders:    []string{"Link"},
 		AllowCredentials: false,
 		MaxAge:           300,
@@ -63,7 +97,24 @@ func NewHttpServer(cfg core.AppConfigProvider, slogLogger *slog.Logger, baseApiP
 		},
 	}))
 
+	spec, err := docs.LoadSpec()
+	if err != nil {
+		log.Fatalf("failed to load openapi spec: %v", err)
+	}
+	docsHandler, err := docs.NewDocsHandler(spec)
+	if err != nil {
+		log.Fatalf("failed to prepare openapi docs: %v", err)
+	}
+
 	apiGroup := app.Group(baseApiPrefix)
+	if cfg.GetOpenAPIValidationEnabled() {
+		validation, err := middleware.OpenAPIValidationMiddleware(spec, baseApiPrefix, slogLogger)
+		if err != nil {
+			log.Fatalf("failed to set up openapi validation: %v", err)
+		}
+		apiGroup.Use(validation)
+		slogLogger.Info("openapi request and response validation enabled.")
+	}
 	slogLogger.Info("fiber HTTP server core initialized with middleware.", slog.String("baseApiPrefix", baseApiPrefix))
 
 	return &HttpServer{
@@ -72,6 +123,8 @@ func NewHttpServer(cfg core.AppConfigProvider, slogLogger *slog.Logger, baseApiP
 		fiberApp:      app,
 		apiBaseRouter: apiGroup,
 		basePath:      baseApiPrefix,
+		docs:          docsHandler,
+		auth:          middleware.AuthMiddleware(verifier, apiKeys, cfg.GetAuthLegacyHeaderEnabled()),
 	}
 }
 
@@ -89,25 +142,110 @@ func (s *HttpServer) Start() {
 }
 
 func (s *HttpServer) SetupRoute(routeGroup *RouteGroup) {
-	if routeGroup.product == nil {
+	if routeGroup.product == nil || routeGroup.installment == nil || routeGroup.apiKey == nil || routeGroup.search == nil || routeGroup.wishlist == nil || routeGroup.sharing == nil || routeGroup.tag == nil || routeGroup.settings == nil {
 		s.log.Error("failed to set up route")
 	}
 
 	productHandler := routeGroup.product
+	installmentHandler := routeGroup.installment
+	apiKeyHandler := routeGroup.apiKey
+	searchHandler := routeGroup.search
+	wishlistHandler := routeGroup.wishlist
+	sharingHandler := routeGroup.sharing
+	tagHandler := routeGroup.tag
+	settingsHandler := routeGroup.settings
+
+	// api documentation
+	s.fiberApp.Get("/openapi.json", s.docs.GetSpec)
+	s.fiberApp.Get("/docs", s.docs.GetDocs)
 
 	s.registerAPIGroup("/health", func(router fiber.Router) {
 		router.Get("/liveness", func(c fiber.Ctx) error { return c.JSON(fiber.Map{"message": "application is running"}) })
 	})
 
 	s.registerAPIGroup("/products", func(router fiber.Router) {
+		router.Use(s.auth)
+
+		// static paths go before /:id
+		router.Get("/summary", productHandler.GetSummary)
+		router.Get("/search", searchHandler.SearchProducts)
+
 		// core product
 		router.Get("/:id", productHandler.GetProduct)
 		router.Get("/", productHandler.GetAllProducts)
 		router.Post("/", productHandler.CreateProduct)
 		router.Put("/positions", productHandler.MoveProductPosition)
+		router.Post("/positions/rebalance", productHandler.RebalancePositions)
+		router.Put("/order", productHandler.ReorderProducts)
+		router.Patch("/:id", productHandler.UpdateProduct)
 		router.Delete("/:id", productHandler.DeleteProduct)
 
+		// status lifecycle
+		router.Post("/:id/transitions", productHandler.TransitionStatus)
+		router.Get("/:id/transitions", productHandler.GetStatusHistory)
+
+		// tags
+		router.Post("/:id/tags", productHandler.TagProduct)
+		router.Delete("/:id/tags/:tagId", productHandler.UntagProduct)
+
+		// installment plan
+		router.Post("/:id/installment", installmentHandler.CreatePlan)
+		router.Get("/:id/installment", installmentHandler.GetPlan)
+		router.Delete("/:id/installment", installmentHandler.CancelPlan)
+		router.Get("/:id/installment/schedule", installmentHandler.GetSchedule)
+		router.Post("/:id/installment/payments", installmentHandler.RecordPayment)
+
 		router.Post("/causes", productHandler.CreateCauses)
+		router.Put("/:id/causes/order", productHandler.ReorderCauses)
+		router.Patch("/:id/causes/:causeId", productHandler.UpdateCause)
+		router.Delete("/:id/causes/:causeId", productHandler.DeleteCause)
+	})
+
+	s.registerAPIGroup("/wishlists", func(router fiber.Router) {
+		router.Use(s.auth)
+
+		router.Get("/", wishlistHandler.ListWishlists)
+		router.Post("/", wishlistHandler.CreateWishlist)
+		router.Get("/:id", wishlistHandler.GetWishlist)
+		router.Patch("/:id", wishlistHandler.UpdateWishlist)
+		router.Delete("/:id", wishlistHandler.DeleteWishlist)
+
+		// sharing
+		router.Get("/:id/shares", sharingHandler.ListShares)
+		router.Post("/:id/shares", sharingHandler.Invite)
+	})
+
+	s.registerAPIGroup("/tags", func(router fiber.Router) {
+		router.Use(s.auth)
+
+		router.Get("/", tagHandler.ListTags)
+		router.Post("/", tagHandler.CreateTag)
+		router.Get("/:id", tagHandler.GetTag)
+		router.Patch("/:id", tagHandler.RenameTag)
+		router.Delete("/:id", tagHandler.DeleteTag)
+	})
+
+	s.registerAPIGroup("/settings", func(router fiber.Router) {
+		router.Use(s.auth)
+
+		router.Get("/", settingsHandler.GetSettings)
+		router.Patch("/", settingsHandler.UpdateSettings)
+	})
+
+	s.registerAPIGroup("/invitations", func(router fiber.Router) {
+		router.Use(s.auth)
+
+		router.Get("/", sharingHandler.ListInvitations)
+		router.Post("/:id/accept", sharingHandler.AcceptInvitation)
+		router.Delete("/:id", sharingHandler.RevokeInvitation)
+	})
+
+	s.registerAPIGroup("/api-keys", func(router fiber.Router) {
+		router.Use(s.auth)
+
+		router.Post("/", apiKeyHandler.CreateApiKey)
+		router.Get("/", apiKeyHandler.ListApiKeys)
+		router.Delete("/:id", apiKeyHandler.RevokeApiKey)
 	})
 }
 
@@ -0,0 +1,6 @@
+package settings
+
+// UpdateSettingsRequest only changes the fields that are present.
+type UpdateSettingsRequest struct {
+	CoolingOffHours *int `json:"coolingOffHours" validate:"omitnil,min=0,max=8760"`
+}
@@ -0,0 +1,76 @@
+package settings
+
+import (
+	"log/slog"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v3"
+	dto "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/shared/dto"
+	core "github.com/zhunismp/intent-products-api/internal/core/domain/settings"
+)
+
+type SettingsHttpHandler struct {
+	settingsSvc  core.SettingsUsecase
+	reqValidator *validator.Validate
+	logger       *slog.Logger
+}
+
+func NewSettingsHttpHandler(settingsSvc core.SettingsUsecase, logger *slog.Logger) *SettingsHttpHandler {
+	return &SettingsHttpHandler{
+		settingsSvc:  settingsSvc,
+		reqValidator: validator.New(),
+		logger:       logger,
+	}
+}
+
+func (h *SettingsHttpHandler) GetSettings(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	// calling svc
+	settings, err := h.settingsSvc.GetSettings(c.Context(), ownerID)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "get settings successfully", settings)
+}
+
+func (h *SettingsHttpHandler) UpdateSettings(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	req := new(UpdateSettingsRequest)
+
+	// parse request body
+	if err := c.Bind().Body(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse request body"})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	// calling svc
+	settings, err := h.settingsSvc.UpdateSettings(c.Context(), ownerID, &core.SettingsPatch{
+		CoolingOffHours: req.CoolingOffHours,
+	})
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "settings were updated successfully", settings)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/auth"
 )
 
 type SuccessResponse struct {
@@ -21,6 +22,11 @@ type ValidationErrorResponse struct {
 	ErrorFields  map[string]string `json:"errorFields"`
 }
 
+// GetUserId returns the id of the authenticated caller, pass its error to HandleError.
+func GetUserId(c fiber.Ctx) (uint, error) {
+	return auth.UserID(c.Context())
+}
+
 func HandleError(c fiber.Ctx, err error) error {
 	var appErr *apperrors.AppError
 	if errors.As(err, &appErr) {
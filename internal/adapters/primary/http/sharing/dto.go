@@ -0,0 +1,10 @@
+package sharing
+
+type InviteRequest struct {
+	UserID uint   `json:"userId" validate:"required"`
+	Role   string `json:"role" validate:"required,oneof=viewer editor"`
+}
+
+type ListInvitationsRequest struct {
+	Status string `query:"status" validate:"omitempty,oneof=pending accepted revoked"`
+}
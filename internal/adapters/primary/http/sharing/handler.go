@@ -0,0 +1,160 @@
+package sharing
+
+import (
+	"log/slog"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v3"
+	dto "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/shared/dto"
+	core "github.com/zhunismp/intent-products-api/internal/core/domain/sharing"
+)
+
+type SharingHttpHandler struct {
+	sharingSvc   core.SharingUsecase
+	reqValidator *validator.Validate
+	logger       *slog.Logger
+}
+
+func NewSharingHttpHandler(sharingSvc core.SharingUsecase, logger *slog.Logger) *SharingHttpHandler {
+	return &SharingHttpHandler{
+		sharingSvc:   sharingSvc,
+		reqValidator: validator.New(),
+		logger:       logger,
+	}
+}
+
+func (h *SharingHttpHandler) Invite(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	listID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	req := new(InviteRequest)
+
+	// parse request body
+	if err := c.Bind().Body(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse request body"})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	// calling svc
+	share, err := h.sharingSvc.Invite(c.Context(), ownerID, uint(listID), req.UserID, req.Role)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusCreated, "invitation was sent successfully", share)
+}
+
+func (h *SharingHttpHandler) ListShares(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	listID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	// calling svc
+	shares, err := h.sharingSvc.ListShares(c.Context(), ownerID, uint(listID))
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "get wishlist shares successfully", shares)
+}
+
+func (h *SharingHttpHandler) ListInvitations(c fiber.Ctx) error {
+	userID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	req := new(ListInvitationsRequest)
+
+	// parse query string
+	if err := c.Bind().Query(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse query parameters"})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	// calling svc
+	shares, err := h.sharingSvc.ListInvitations(c.Context(), userID, req.Status)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "get invitations successfully", shares)
+}
+
+func (h *SharingHttpHandler) AcceptInvitation(c fiber.Ctx) error {
+	userID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	shareID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	// calling svc
+	share, err := h.sharingSvc.Accept(c.Context(), userID, uint(shareID))
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "invitation was accepted successfully", share)
+}
+
+func (h *SharingHttpHandler) RevokeInvitation(c fiber.Ctx) error {
+	userID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	shareID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	// calling svc
+	share, err := h.sharingSvc.Revoke(c.Context(), userID, uint(shareID))
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "invitation was revoked successfully", share)
+}
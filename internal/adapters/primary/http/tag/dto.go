@@ -0,0 +1,9 @@
+package tag
+
+type CreateTagRequest struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
+
+type RenameTagRequest struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
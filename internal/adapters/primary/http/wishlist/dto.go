@@ -0,0 +1,20 @@
+package wishlist
+
+type CreateWishlistRequest struct {
+	Name  string `json:"name" validate:"required,max=100"`
+	Emoji string `json:"emoji" validate:"omitempty,max=16"`
+	Color string `json:"color" validate:"omitempty,len=7,hexcolor"`
+}
+
+// UpdateWishlistRequest only changes the fields that are present, an empty color or emoji
+// clears it.
+type UpdateWishlistRequest struct {
+	Name     *string `json:"name" validate:"omitnil,min=1,max=100"`
+	Emoji    *string `json:"emoji" validate:"omitnil,max=16"`
+	Color    *string `json:"color" validate:"omitnil,max=7"`
+	Archived *bool   `json:"archived"`
+}
+
+type ListWishlistsRequest struct {
+	Archived bool `query:"archived"`
+}
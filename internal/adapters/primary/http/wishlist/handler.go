@@ -0,0 +1,166 @@
+package wishlist
+
+import (
+	"log/slog"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v3"
+	dto "github.com/zhunismp/intent-products-api/internal/adapters/primary/http/shared/dto"
+	core "github.com/zhunismp/intent-products-api/internal/core/domain/wishlist"
+)
+
+type WishlistHttpHandler struct {
+	wishlistSvc  core.WishlistUsecase
+	reqValidator *validator.Validate
+	logger       *slog.Logger
+}
+
+func NewWishlistHttpHandler(wishlistSvc core.WishlistUsecase, logger *slog.Logger) *WishlistHttpHandler {
+	return &WishlistHttpHandler{
+		wishlistSvc:  wishlistSvc,
+		reqValidator: validator.New(),
+		logger:       logger,
+	}
+}
+
+func (h *WishlistHttpHandler) CreateWishlist(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	req := new(CreateWishlistRequest)
+
+	// parse request body
+	if err := c.Bind().Body(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse request body"})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	// calling svc
+	list, err := h.wishlistSvc.CreateWishlist(c.Context(), ownerID, req.Name, req.Emoji, req.Color)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusCreated, "wishlist was created successfully", list)
+}
+
+func (h *WishlistHttpHandler) ListWishlists(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	req := new(ListWishlistsRequest)
+
+	// parse query string
+	if err := c.Bind().Query(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse query parameters"})
+	}
+
+	// calling svc
+	lists, err := h.wishlistSvc.ListWishlists(c.Context(), ownerID, req.Archived)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "get wishlists successfully", lists)
+}
+
+func (h *WishlistHttpHandler) GetWishlist(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	listID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	// calling svc
+	list, err := h.wishlistSvc.GetWishlist(c.Context(), ownerID, uint(listID))
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "get wishlist successfully", list)
+}
+
+func (h *WishlistHttpHandler) UpdateWishlist(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	listID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	req := new(UpdateWishlistRequest)
+
+	// parse request body
+	if err := c.Bind().Body(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse request body"})
+	}
+
+	// validate req
+	if err := h.reqValidator.Struct(req); err != nil {
+		if errs, ok := err.(validator.ValidationErrors); ok {
+			errMap := dto.GenerateErrorMap(errs)
+			return c.Status(fiber.StatusBadRequest).JSON(dto.ValidationErrorResponse{
+				ErrorMessage: "invalid request",
+				ErrorFields:  errMap,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{ErrorMessage: "something went wrong"})
+	}
+
+	// calling svc
+	list, err := h.wishlistSvc.UpdateWishlist(c.Context(), ownerID, uint(listID), &core.WishlistPatch{
+		Name:     req.Name,
+		Emoji:    req.Emoji,
+		Color:    req.Color,
+		Archived: req.Archived,
+	})
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "wishlist was updated successfully", list)
+}
+
+func (h *WishlistHttpHandler) DeleteWishlist(c fiber.Ctx) error {
+	ownerID, err := dto.GetUserId(c)
+	if err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	listID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{ErrorMessage: "can not parse id"})
+	}
+
+	// calling svc
+	if err := h.wishlistSvc.DeleteWishlist(c.Context(), ownerID, uint(listID)); err != nil {
+		return dto.HandleError(c, err)
+	}
+
+	return dto.HandleResponse(c, fiber.StatusOK, "wishlist was deleted successfully", listID)
+}
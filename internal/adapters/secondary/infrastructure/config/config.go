@@ -7,6 +7,14 @@ type ServerConfig struct {
 	Port          string
 	GrpcPort      string
 	BaseApiPrefix string
+
+	OpenAPIValidationEnabled bool
+
+	GatewayEnabled bool
+	GatewayPort    string
+	// GatewayGrpcTarget is where the gateway reaches the gRPC server, empty derives it
+	// from the server host and gRPC port
+	GatewayGrpcTarget string
 }
 
 type DatabaseConfig struct {
@@ -19,6 +27,18 @@ type DatabaseConfig struct {
 	Timezone string
 }
 
+type AuthConfig struct {
+	JwksFile            string
+	JwksURL             string
+	Issuer              string
+	Audience            string
+	LegacyHeaderEnabled bool
+}
+
+type ProductConfig struct {
+	PositionMaxKeyLength int
+}
+
 type LoggerConfig struct {
 	LogLevel    string
 	LogFilePath string
@@ -31,7 +51,9 @@ type LoggerConfig struct {
 }
 
 type AppEnvConfig struct {
-	serverCfg *ServerConfig
-	dbCfg     *DatabaseConfig
-	loggerCfg *LoggerConfig
+	serverCfg  *ServerConfig
+	dbCfg      *DatabaseConfig
+	authCfg    *AuthConfig
+	productCfg *ProductConfig
+	loggerCfg  *LoggerConfig
 }
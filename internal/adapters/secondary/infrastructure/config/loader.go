@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"strconv"
 	"sync"
@@ -13,6 +14,8 @@ import (
 
 var _ config.ServerConfigProvider = (*AppEnvConfig)(nil)
 var _ config.DatabaseConfigProvider = (*AppEnvConfig)(nil)
+var _ config.AuthConfigProvider = (*AppEnvConfig)(nil)
+var _ config.ProductConfigProvider = (*AppEnvConfig)(nil)
 var _ config.AppConfigProvider = (*AppEnvConfig)(nil)
 
 var (
@@ -31,12 +34,18 @@ func LoadConfig(envFilePath ...string) (*AppEnvConfig, error) {
 		}
 
 		serverCfg := &ServerConfig{
-			Env:           getEnv("SERVER_ENV", "development"),
-			Name:          getEnv("SERVER_NAME", "product-api-dev"),
-			Host:          getEnv("SERVER_HOST", "0.0.0.0"),
-			Port:          getEnv("SERVER_PORT", "8080"),
-			GrpcPort:      getEnv("GRPC_SERVER_PORT", "9000"),
-			BaseApiPrefix: getEnv("SERVER_BASEAPIPREFIX", "/api/v1"),
+			Env:            getEnv("SERVER_ENV", "development"),
+			Name:           getEnv("SERVER_NAME", "product-api-dev"),
+			Host:           getEnv("SERVER_HOST", "0.0.0.0"),
+			Port:           getEnv("SERVER_PORT", "8080"),
+			GrpcPort:       getEnv("GRPC_SERVER_PORT", "9000"),
+			BaseApiPrefix:  getEnv("SERVER_BASEAPIPREFIX", "/api/v1"),
+			GatewayEnabled: mustParseBool(getEnv("GATEWAY_ENABLED", "false"), "GATEWAY_ENABLED"),
+			GatewayPort:    getEnv("GATEWAY_PORT", "8081"),
+
+			GatewayGrpcTarget: getEnv("GATEWAY_GRPC_TARGET", ""),
+
+			OpenAPIValidationEnabled: mustParseBool(getEnv("OPENAPI_VALIDATION_ENABLED", "false"), "OPENAPI_VALIDATION_ENABLED"),
 		}
 
 		dbCfg := &DatabaseConfig{
@@ -49,6 +58,20 @@ func LoadConfig(envFilePath ...string) (*AppEnvConfig, error) {
 			Timezone: getEnv("DB_TIMEZONE", "Asia/Bangkok"),
 		}
 
+		// legacy header trusts X-User-Id as is, only for internal service-to-service calls
+		authCfg := &AuthConfig{
+			JwksFile:            getEnv("AUTH_JWKS_FILE", ""),
+			JwksURL:             getEnv("AUTH_JWKS_URL", ""),
+			Issuer:              getEnv("AUTH_ISSUER", ""),
+			Audience:            getEnv("AUTH_AUDIENCE", ""),
+			LegacyHeaderEnabled: mustParseBool(getEnv("AUTH_LEGACY_HEADER_ENABLED", "false"), "AUTH_LEGACY_HEADER_ENABLED"),
+		}
+
+		// keys longer than this trigger a rebalance of the owner's positions, the column holds 255
+		productCfg := &ProductConfig{
+			PositionMaxKeyLength: mustParseInt(getEnv("POSITION_MAX_KEY_LENGTH", "64"), "POSITION_MAX_KEY_LENGTH"),
+		}
+
 		// Parse logger config values
 		maxSize := mustParseInt(getEnv("LOGGING_MAXSIZE", "100"), "LOGGING_MAXSIZE")
 		maxBackups := mustParseInt(getEnv("LOGGING_MAXBACKUPS", "3"), "LOGGING_MAXBACKUPS")
@@ -70,11 +93,17 @@ func LoadConfig(envFilePath ...string) (*AppEnvConfig, error) {
 			loadErr = fmt.Errorf("DB_USER cannot be empty")
 			return
 		}
+		if productCfg.PositionMaxKeyLength < 16 || productCfg.PositionMaxKeyLength > 200 {
+			loadErr = fmt.Errorf("POSITION_MAX_KEY_LENGTH must be between 16 and 200")
+			return
+		}
 
 		loadedConfig = &AppEnvConfig{
-			serverCfg: serverCfg,
-			dbCfg:     dbCfg,
-			loggerCfg: loggerCfg,
+			serverCfg:  serverCfg,
+			dbCfg:      dbCfg,
+			authCfg:    authCfg,
+			productCfg: productCfg,
+			loggerCfg:  loggerCfg,
 		}
 
 		log.Println("INFO: Application configuration loaded successfully.")
@@ -119,6 +148,36 @@ func (c *AppEnvConfig) GetServerHost() string          { return c.serverCfg.Host
 func (c *AppEnvConfig) GetServerPort() string          { return c.serverCfg.Port }
 func (c *AppEnvConfig) GetServerBaseApiPrefix() string { return c.serverCfg.BaseApiPrefix }
 func (c *AppEnvConfig) GetGrpcServerPort() string      { return c.serverCfg.GrpcPort }
+func (c *AppEnvConfig) GetGatewayEnabled() bool        { return c.serverCfg.GatewayEnabled }
+func (c *AppEnvConfig) GetGatewayPort() string         { return c.serverCfg.GatewayPort }
+
+// GetGatewayGrpcTarget falls back to the gRPC server of this process, a wildcard listen
+// host is dialed as localhost.
+func (c *AppEnvConfig) GetGatewayGrpcTarget() string {
+	if c.serverCfg.GatewayGrpcTarget != "" {
+		return c.serverCfg.GatewayGrpcTarget
+	}
+
+	host := c.serverCfg.Host
+	if ip := net.ParseIP(host); host == "" || ip != nil && ip.IsUnspecified() {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, c.serverCfg.GrpcPort)
+}
+
+func (c *AppEnvConfig) GetOpenAPIValidationEnabled() bool {
+	return c.serverCfg.OpenAPIValidationEnabled
+}
+
+/* Auth Cfg */
+func (c *AppEnvConfig) GetAuthJwksFile() string          { return c.authCfg.JwksFile }
+func (c *AppEnvConfig) GetAuthJwksURL() string           { return c.authCfg.JwksURL }
+func (c *AppEnvConfig) GetAuthIssuer() string            { return c.authCfg.Issuer }
+func (c *AppEnvConfig) GetAuthAudience() string          { return c.authCfg.Audience }
+func (c *AppEnvConfig) GetAuthLegacyHeaderEnabled() bool { return c.authCfg.LegacyHeaderEnabled }
+
+/* Product Cfg */
+func (c *AppEnvConfig) GetPositionMaxKeyLength() int { return c.productCfg.PositionMaxKeyLength }
 
 /* Database Cfg */
 func (c *AppEnvConfig) GetDBHost() string     { return c.dbCfg.Host }
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"net/url"
+	"strconv"
+)
+
+const redacted = "******"
+
+// ConfigEntry is one setting under the environment variable it is loaded from.
+type ConfigEntry struct {
+	Key    string
+	Value  string
+	Secret bool
+}
+
+// RedactedEntries lists the effective configuration with secrets masked, safe to print or
+// paste in a ticket. Credentials inside URLs are masked as well.
+func (c *AppEnvConfig) RedactedEntries() []ConfigEntry {
+	entries := []ConfigEntry{
+		{Key: "SERVER_ENV", Value: c.serverCfg.Env},
+		{Key: "SERVER_NAME", Value: c.serverCfg.Name},
+		{Key: "SERVER_HOST", Value: c.serverCfg.Host},
+		{Key: "SERVER_PORT", Value: c.serverCfg.Port},
+		{Key: "GRPC_SERVER_PORT", Value: c.serverCfg.GrpcPort},
+		{Key: "SERVER_BASEAPIPREFIX", Value: c.serverCfg.BaseApiPrefix},
+		{Key: "OPENAPI_VALIDATION_ENABLED", Value: strconv.FormatBool(c.serverCfg.OpenAPIValidationEnabled)},
+		{Key: "GATEWAY_ENABLED", Value: strconv.FormatBool(c.serverCfg.GatewayEnabled)},
+		{Key: "GATEWAY_PORT", Value: c.serverCfg.GatewayPort},
+		{Key: "GATEWAY_GRPC_TARGET", Value: c.GetGatewayGrpcTarget()},
+
+		{Key: "DB_HOST", Value: c.dbCfg.Host},
+		{Key: "DB_PORT", Value: c.dbCfg.Port},
+		{Key: "DB_USER", Value: c.dbCfg.User},
+		{Key: "DB_PASSWORD", Value: c.dbCfg.Password, Secret: true},
+		{Key: "DB_NAME", Value: c.dbCfg.Name},
+		{Key: "DB_SSLMODE", Value: c.dbCfg.SSLMode},
+		{Key: "DB_TIMEZONE", Value: c.dbCfg.Timezone},
+
+		{Key: "AUTH_JWKS_FILE", Value: c.authCfg.JwksFile},
+		{Key: "AUTH_JWKS_URL", Value: redactURL(c.authCfg.JwksURL)},
+		{Key: "AUTH_ISSUER", Value: c.authCfg.Issuer},
+		{Key: "AUTH_AUDIENCE", Value: c.authCfg.Audience},
+		{Key: "AUTH_LEGACY_HEADER_ENABLED", Value: strconv.FormatBool(c.authCfg.LegacyHeaderEnabled)},
+
+		{Key: "POSITION_MAX_KEY_LENGTH", Value: strconv.Itoa(c.productCfg.PositionMaxKeyLength)},
+
+		{Key: "LOGGING_LEVEL", Value: c.loggerCfg.LogLevel},
+		{Key: "LOGGING_PATH", Value: c.loggerCfg.LogFilePath},
+		{Key: "LOGGING_MAXSIZE", Value: strconv.Itoa(c.loggerCfg.MaxSize)},
+		{Key: "LOGGING_MAXBACKUPS", Value: strconv.Itoa(c.loggerCfg.MaxBackups)},
+		{Key: "LOGGING_MAXAGE", Value: strconv.Itoa(c.loggerCfg.MaxAge)},
+		{Key: "LOGGING_COMPRESS", Value: strconv.FormatBool(c.loggerCfg.Compress)},
+		{Key: "LOGGING_ENDPOINT", Value: c.loggerCfg.Endpoint},
+	}
+
+	for i := range entries {
+		if entries[i].Secret && entries[i].Value != "" {
+			entries[i].Value = redacted
+		}
+	}
+
+	return entries
+}
+
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.User == nil {
+		return raw
+	}
+	return u.Redacted()
+}
@@ -0,0 +1,464 @@
+package database
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationLockID is the advisory lock every replica takes before touching the schema,
+// any constant works as long as it does not change between releases
+const migrationLockID int64 = 7_241_305_118
+
+// noTransactionMarker opts a migration out of the transaction, for statements such as
+// CREATE INDEX CONCURRENTLY. Such a migration is left dirty when it fails half way.
+const noTransactionMarker = "-- migrate:no-transaction"
+
+var migrationFileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+var (
+	ErrSchemaBehind = errors.New("database schema is behind")
+	ErrSchemaDirty  = errors.New("database schema is dirty")
+)
+
+type Migration struct {
+	Version uint
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationState is a migration as known to the binary, the database, or both.
+type MigrationState struct {
+	Version   uint
+	Name      string
+	Applied   bool
+	Dirty     bool
+	AppliedAt *time.Time
+	// Unknown is set for versions applied by a newer release than this binary
+	Unknown bool
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []*Migration
+	logger     *slog.Logger
+}
+
+func NewMigrator(db *gorm.DB, logger *slog.Logger) (*Migrator, error) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("sql.DB: %w", err)
+	}
+
+	migrations, err := loadMigrations(migrationFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: sqlDB, migrations: migrations, logger: logger}, nil
+}
+
+func loadMigrations(files fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(files, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+
+	byVersion := make(map[uint]*Migration)
+	for _, entry := range entries {
+		match := migrationFileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migration file %q is not named <version>_<name>.<up|down>.sql", entry.Name())
+		}
+
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("migration file %q has an invalid version", entry.Name())
+		}
+
+		content, err := fs.ReadFile(files, "migrations/"+entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read migration %q: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[uint(version)]
+		if !ok {
+			m = &Migration{Version: uint(version), Name: match[2]}
+			byVersion[uint(version)] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d is used by %q and %q", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down file", m.Version, m.Name)
+		}
+		migrations = append(migrations, m)
+	}
+	slices.SortFunc(migrations, func(a, b *Migration) int { return cmp.Compare(a.Version, b.Version) })
+
+	return migrations, nil
+}
+
+// LatestVersion is the version the schema has once every migration of this binary is applied.
+func (m *Migrator) LatestVersion() uint {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Up applies every pending migration in version order and returns how many were applied.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		states, err := m.states(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := checkClean(states); err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if isApplied(states, migration.Version) {
+				continue
+			}
+
+			started := time.Now()
+			if err := m.apply(ctx, conn, migration); err != nil {
+				return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			applied++
+
+			m.logger.InfoContext(ctx, "applied migration",
+				slog.Uint64("version", uint64(migration.Version)),
+				slog.String("name", migration.Name),
+				slog.Duration("took", time.Since(started)),
+			)
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down reverts the last steps applied migrations, newest first, and returns how many were reverted.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	if steps < 1 {
+		return 0, fmt.Errorf("steps must be at least 1")
+	}
+
+	reverted := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		states, err := m.states(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := checkClean(states); err != nil {
+			return err
+		}
+
+		for i := len(states) - 1; i >= 0 && reverted < steps; i-- {
+			state := states[i]
+			if !state.Applied {
+				continue
+			}
+			if state.Unknown {
+				return fmt.Errorf("migration %d was applied by a newer release and can not be reverted by this one", state.Version)
+			}
+
+			migration := m.find(state.Version)
+			started := time.Now()
+			if err := m.revert(ctx, conn, migration); err != nil {
+				return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			reverted++
+
+			m.logger.InfoContext(ctx, "reverted migration",
+				slog.Uint64("version", uint64(migration.Version)),
+				slog.String("name", migration.Name),
+				slog.Duration("took", time.Since(started)),
+			)
+		}
+
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Force records the schema as being exactly at version and clears the dirty flag without
+// running any SQL. It is the way out after fixing a failed migration by hand.
+func (m *Migrator) Force(ctx context.Context, version uint) error {
+	if version != 0 && m.find(version) == nil {
+		return fmt.Errorf("migration %d does not exist", version)
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version > $1", version); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE schema_migrations SET dirty = false WHERE dirty"); err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if migration.Version > version {
+				break
+			}
+			if _, err := tx.ExecContext(ctx,
+				"INSERT INTO schema_migrations (version, name) VALUES ($1, $2) ON CONFLICT (version) DO NOTHING",
+				migration.Version, migration.Name,
+			); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+
+		m.logger.WarnContext(ctx, "forced migration version", slog.Uint64("version", uint64(version)))
+		return nil
+	})
+}
+
+// Status lists every migration of this binary and whether it is applied, followed by
+// versions applied by a newer release.
+func (m *Migrator) Status(ctx context.Context) ([]*MigrationState, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	return m.states(ctx, conn)
+}
+
+// CheckCurrent fails when a migration of this binary is not applied or one is dirty.
+// A schema ahead of the binary passes, an older replica keeps running during a rollout.
+func (m *Migrator) CheckCurrent(ctx context.Context) error {
+	states, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkClean(states); err != nil {
+		return err
+	}
+
+	var pending []string
+	for _, state := range states {
+		if !state.Applied {
+			pending = append(pending, fmt.Sprintf("%d_%s", state.Version, state.Name))
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w, pending migrations: %s", ErrSchemaBehind, strings.Join(pending, ", "))
+	}
+
+	return nil
+}
+
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, migration *Migration) error {
+	if strings.HasPrefix(migration.Up, noTransactionMarker) {
+		// recorded dirty first so a failure half way is visible and blocks further runs
+		if _, err := conn.ExecContext(ctx, "INSERT INTO schema_migrations (version, name, dirty) VALUES ($1, $2, true)", migration.Version, migration.Name); err != nil {
+			return err
+		}
+		if _, err := conn.ExecContext(ctx, migration.Up); err != nil {
+			return err
+		}
+		_, err := conn.ExecContext(ctx, "UPDATE schema_migrations SET dirty = false, applied_at = now() WHERE version = $1", migration.Version)
+		return err
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", migration.Version, migration.Name); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (m *Migrator) revert(ctx context.Context, conn *sql.Conn, migration *Migration) error {
+	if strings.HasPrefix(migration.Down, noTransactionMarker) {
+		if _, err := conn.ExecContext(ctx, "UPDATE schema_migrations SET dirty = true WHERE version = $1", migration.Version); err != nil {
+			return err
+		}
+		if _, err := conn.ExecContext(ctx, migration.Down); err != nil {
+			return err
+		}
+		_, err := conn.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = $1", migration.Version)
+		return err
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, migration.Down); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = $1", migration.Version); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// withLock runs fn on a single connection holding the migration advisory lock, session
+// level locks belong to the connection so everything has to go through it
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	m.logger.InfoContext(ctx, "waiting for the migration lock")
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer func() {
+		// a fresh context so the lock is released even when ctx is already canceled
+		unlockCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := conn.ExecContext(unlockCtx, "SELECT pg_advisory_unlock($1)", migrationLockID); err != nil {
+			m.logger.ErrorContext(ctx, "failed to release the migration lock", slog.Any("error", err))
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    bigint PRIMARY KEY,
+		name       text NOT NULL,
+		dirty      boolean NOT NULL DEFAULT false,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`); err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+
+	return fn(conn)
+}
+
+// states merges the migrations of the binary with the rows of schema_migrations, ordered by version
+func (m *Migrator) states(ctx context.Context, conn *sql.Conn) ([]*MigrationState, error) {
+	states := make([]*MigrationState, 0, len(m.migrations))
+	byVersion := make(map[uint]*MigrationState, len(m.migrations))
+	for _, migration := range m.migrations {
+		state := &MigrationState{Version: migration.Version, Name: migration.Name}
+		states = append(states, state)
+		byVersion[migration.Version] = state
+	}
+
+	var exists bool
+	if err := conn.QueryRowContext(ctx, "SELECT to_regclass('schema_migrations') IS NOT NULL").Scan(&exists); err != nil {
+		return nil, fmt.Errorf("read schema_migrations: %w", err)
+	}
+	if !exists {
+		return states, nil
+	}
+
+	rows, err := conn.QueryContext(ctx, "SELECT version, name, dirty, applied_at FROM schema_migrations ORDER BY version")
+	if err != nil {
+		return nil, fmt.Errorf("read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			version   int64
+			name      string
+			dirty     bool
+			appliedAt time.Time
+		)
+		if err := rows.Scan(&version, &name, &dirty, &appliedAt); err != nil {
+			return nil, fmt.Errorf("read schema_migrations: %w", err)
+		}
+
+		state, ok := byVersion[uint(version)]
+		if !ok {
+			state = &MigrationState{Version: uint(version), Name: name, Unknown: true}
+			states = append(states, state)
+		}
+		state.Applied = true
+		state.Dirty = dirty
+		state.AppliedAt = &appliedAt
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read schema_migrations: %w", err)
+	}
+
+	slices.SortFunc(states, func(a, b *MigrationState) int { return cmp.Compare(a.Version, b.Version) })
+
+	return states, nil
+}
+
+func (m *Migrator) find(version uint) *Migration {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration
+		}
+	}
+	return nil
+}
+
+func checkClean(states []*MigrationState) error {
+	for _, state := range states {
+		if state.Dirty {
+			return fmt.Errorf("%w at migration %d_%s, fix it by hand then force a version", ErrSchemaDirty, state.Version, state.Name)
+		}
+	}
+	return nil
+}
+
+func isApplied(states []*MigrationState, version uint) bool {
+	for _, state := range states {
+		if state.Version == version {
+			return state.Applied
+		}
+	}
+	return false
+}
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS api_keys;
+DROP TABLE IF EXISTS installment_payments;
+DROP TABLE IF EXISTS installment_plans;
+DROP TABLE IF EXISTS causes;
+DROP TABLE IF EXISTS product_status_history;
+DROP TABLE IF EXISTS products;
@@ -0,0 +1,87 @@
+-- The schema previously created by gorm AutoMigrate. Everything is IF NOT EXISTS so
+-- databases that were auto migrated adopt this version without changes.
+
+CREATE TABLE IF NOT EXISTS products (
+    id         bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    owner_id   bigint       NOT NULL,
+    name       varchar(255) NOT NULL,
+    image_url  text,
+    link       text,
+    price      decimal      NOT NULL,
+    status     varchar(50)  NOT NULL DEFAULT 'active',
+    position   varchar(255) COLLATE "C" NOT NULL,
+    CONSTRAINT chk_products_price CHECK (price >= 0)
+);
+CREATE INDEX IF NOT EXISTS idx_products_deleted_at ON products (deleted_at);
+
+CREATE TABLE IF NOT EXISTS product_status_history (
+    id          bigserial PRIMARY KEY,
+    product_id  bigint      NOT NULL,
+    changed_by  bigint      NOT NULL,
+    from_status varchar(50) NOT NULL,
+    to_status   varchar(50) NOT NULL,
+    changed_at  timestamptz NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_product_status_history_product_id ON product_status_history (product_id);
+
+CREATE TABLE IF NOT EXISTS causes (
+    id         bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    product_id bigint  NOT NULL,
+    reason     text    NOT NULL,
+    status     boolean NOT NULL DEFAULT true
+);
+CREATE INDEX IF NOT EXISTS idx_causes_deleted_at ON causes (deleted_at);
+
+CREATE TABLE IF NOT EXISTS installment_plans (
+    id            bigserial PRIMARY KEY,
+    created_at    timestamptz,
+    updated_at    timestamptz,
+    deleted_at    timestamptz,
+    product_id    bigint  NOT NULL,
+    owner_id      bigint  NOT NULL,
+    total         decimal NOT NULL,
+    months        bigint  NOT NULL,
+    start_date    date    NOT NULL,
+    interest_rate decimal NOT NULL DEFAULT 0,
+    CONSTRAINT chk_installment_plans_total CHECK (total > 0),
+    CONSTRAINT chk_installment_plans_months CHECK (months > 0),
+    CONSTRAINT chk_installment_plans_interest_rate CHECK (interest_rate >= 0)
+);
+CREATE INDEX IF NOT EXISTS idx_installment_plans_deleted_at ON installment_plans (deleted_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_installment_plans_product_id ON installment_plans (product_id);
+
+CREATE TABLE IF NOT EXISTS installment_payments (
+    id         bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    plan_id    bigint      NOT NULL,
+    amount     decimal     NOT NULL,
+    paid_at    timestamptz NOT NULL,
+    CONSTRAINT chk_installment_payments_amount CHECK (amount > 0)
+);
+CREATE INDEX IF NOT EXISTS idx_installment_payments_deleted_at ON installment_payments (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_installment_payments_plan_id ON installment_payments (plan_id);
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    id           bigserial PRIMARY KEY,
+    created_at   timestamptz,
+    updated_at   timestamptz,
+    deleted_at   timestamptz,
+    owner_id     bigint      NOT NULL,
+    name         text        NOT NULL,
+    prefix       varchar(32) NOT NULL,
+    hash         char(64)    NOT NULL,
+    scopes       text        NOT NULL,
+    last_used_at timestamptz,
+    revoked_at   timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_api_keys_deleted_at ON api_keys (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_api_keys_owner_id ON api_keys (owner_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_prefix ON api_keys (prefix);
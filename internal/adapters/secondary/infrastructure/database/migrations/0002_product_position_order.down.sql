@@ -0,0 +1,4 @@
+ALTER TABLE products ALTER COLUMN status SET DEFAULT 'active';
+
+DROP INDEX IF EXISTS idx_causes_product_id;
+DROP INDEX IF EXISTS idx_products_owner_position;
@@ -0,0 +1,10 @@
+-- AutoMigrate did not reliably apply the collation to an existing column, positions
+-- are fractional index keys and only sort correctly byte by byte.
+ALTER TABLE products ALTER COLUMN position TYPE varchar(255) COLLATE "C";
+
+-- every product query is scoped to the owner and most of them walk the position order
+CREATE INDEX IF NOT EXISTS idx_products_owner_position ON products (owner_id, position) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_causes_product_id ON causes (product_id) WHERE deleted_at IS NULL;
+
+-- new products always start pending, 'active' is not a product status
+ALTER TABLE products ALTER COLUMN status SET DEFAULT 'pending';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_products_owner_position;
+CREATE INDEX idx_products_owner_position ON products (owner_id, position) WHERE deleted_at IS NULL;
@@ -0,0 +1,14 @@
+-- Concurrent moves into the same gap could write the same key, which leaves the order
+-- ambiguous. Existing duplicates get a distinct suffix first, a key extended with more
+-- digits still sorts right after the original and before the next key.
+UPDATE products p
+SET position = p.position || 'V' || d.rn::text || 'V'
+FROM (
+    SELECT id, row_number() OVER (PARTITION BY owner_id, position ORDER BY id) AS rn
+    FROM products
+    WHERE deleted_at IS NULL
+) d
+WHERE p.id = d.id AND d.rn > 1;
+
+DROP INDEX IF EXISTS idx_products_owner_position;
+CREATE UNIQUE INDEX idx_products_owner_position ON products (owner_id, position) WHERE deleted_at IS NULL;
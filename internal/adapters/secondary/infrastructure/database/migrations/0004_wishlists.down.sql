@@ -0,0 +1,17 @@
+-- Folding the lists back into one list per owner can make two products share a key,
+-- those get a distinct suffix the same way 0003 did before the owner index returns.
+DROP INDEX IF EXISTS idx_products_list_position;
+
+UPDATE products p
+SET position = p.position || 'V' || d.rn::text || 'V'
+FROM (
+    SELECT id, row_number() OVER (PARTITION BY owner_id, position ORDER BY list_id, id) AS rn
+    FROM products
+    WHERE deleted_at IS NULL
+) d
+WHERE p.id = d.id AND d.rn > 1;
+
+CREATE UNIQUE INDEX idx_products_owner_position ON products (owner_id, position) WHERE deleted_at IS NULL;
+
+ALTER TABLE products DROP COLUMN IF EXISTS list_id;
+DROP TABLE IF EXISTS wishlists;
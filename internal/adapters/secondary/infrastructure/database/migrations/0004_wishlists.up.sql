@@ -0,0 +1,34 @@
+-- Products move from one flat list per owner into named wishlists. Every owner with
+-- products gets a default list holding all of them, soft deleted ones included so
+-- list_id can be required, and positions become unique per list instead of per owner.
+
+CREATE TABLE IF NOT EXISTS wishlists (
+    id         bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    owner_id   bigint       NOT NULL,
+    name       varchar(100) NOT NULL,
+    emoji      varchar(16)  NOT NULL DEFAULT '',
+    color      varchar(7)   NOT NULL DEFAULT '',
+    archived   boolean      NOT NULL DEFAULT false,
+    is_default boolean      NOT NULL DEFAULT false
+);
+CREATE INDEX IF NOT EXISTS idx_wishlists_deleted_at ON wishlists (deleted_at);
+CREATE UNIQUE INDEX idx_wishlists_owner_name ON wishlists (owner_id, lower(name)) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX idx_wishlists_owner_default ON wishlists (owner_id) WHERE is_default AND deleted_at IS NULL;
+
+INSERT INTO wishlists (created_at, updated_at, owner_id, name, is_default)
+SELECT now(), now(), owner_id, 'My wishlist', true
+FROM products
+GROUP BY owner_id;
+
+ALTER TABLE products ADD COLUMN list_id bigint;
+UPDATE products p
+SET list_id = w.id
+FROM wishlists w
+WHERE w.owner_id = p.owner_id AND w.is_default;
+ALTER TABLE products ALTER COLUMN list_id SET NOT NULL;
+
+DROP INDEX IF EXISTS idx_products_owner_position;
+CREATE UNIQUE INDEX idx_products_list_position ON products (list_id, position) WHERE deleted_at IS NULL;
@@ -0,0 +1,22 @@
+-- Owners share a wishlist with other users as viewers or editors. A share starts pending
+-- until the invited user accepts it and is kept as revoked once withdrawn or declined, so
+-- only one active share per user and list is allowed.
+
+CREATE TABLE IF NOT EXISTS wishlist_shares (
+    id          bigserial PRIMARY KEY,
+    created_at  timestamptz,
+    updated_at  timestamptz,
+    deleted_at  timestamptz,
+    list_id     bigint      NOT NULL,
+    owner_id    bigint      NOT NULL,
+    user_id     bigint      NOT NULL,
+    role        varchar(16) NOT NULL,
+    status      varchar(16) NOT NULL DEFAULT 'pending',
+    invited_by  bigint      NOT NULL,
+    accepted_at timestamptz,
+    revoked_at  timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_wishlist_shares_deleted_at ON wishlist_shares (deleted_at);
+CREATE INDEX idx_wishlist_shares_user ON wishlist_shares (user_id, status);
+CREATE UNIQUE INDEX idx_wishlist_shares_list_user ON wishlist_shares (list_id, user_id)
+    WHERE status IN ('pending', 'accepted') AND deleted_at IS NULL;
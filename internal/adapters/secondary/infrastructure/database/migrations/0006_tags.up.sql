@@ -0,0 +1,21 @@
+-- Tags group products across wishlists. Names are unique per owner ignoring case, a
+-- product carries any number of its owner's tags.
+
+CREATE TABLE IF NOT EXISTS tags (
+    id         bigserial PRIMARY KEY,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    owner_id   bigint      NOT NULL,
+    name       varchar(50) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_tags_deleted_at ON tags (deleted_at);
+CREATE UNIQUE INDEX idx_tags_owner_name ON tags (owner_id, lower(name)) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS product_tags (
+    product_id bigint NOT NULL,
+    tag_id     bigint NOT NULL,
+    created_at timestamptz,
+    PRIMARY KEY (product_id, tag_id)
+);
+CREATE INDEX idx_product_tags_tag ON product_tags (tag_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_causes_product_position;
+CREATE INDEX IF NOT EXISTS idx_causes_product_id ON causes (product_id) WHERE deleted_at IS NULL;
+
+ALTER TABLE causes DROP COLUMN IF EXISTS position;
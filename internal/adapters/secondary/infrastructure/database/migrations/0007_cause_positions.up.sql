@@ -0,0 +1,23 @@
+-- Causes get a fractional index position like products so they can be reordered. The
+-- existing causes of a product keep their creation order, the n-th one gets the n-th
+-- integer key of the base 62 scheme: a0..az, b00..bzz, c000..
+ALTER TABLE causes ADD COLUMN position varchar(255) COLLATE "C";
+
+UPDATE causes c
+SET position = CASE
+    WHEN k.n < 62 THEN 'a' || substr(k.digits, k.n + 1, 1)
+    WHEN k.n < 3844 THEN 'b' || substr(k.digits, k.n / 62 + 1, 1) || substr(k.digits, k.n % 62 + 1, 1)
+    ELSE 'c' || substr(k.digits, k.n / 3844 + 1, 1) || substr(k.digits, (k.n / 62) % 62 + 1, 1) || substr(k.digits, k.n % 62 + 1, 1)
+END
+FROM (
+    SELECT id,
+           (row_number() OVER (PARTITION BY product_id ORDER BY id) - 1)::int AS n,
+           '0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz'::text AS digits
+    FROM causes
+) k
+WHERE c.id = k.id;
+
+ALTER TABLE causes ALTER COLUMN position SET NOT NULL;
+
+DROP INDEX IF EXISTS idx_causes_product_id;
+CREATE INDEX idx_causes_product_position ON causes (product_id, position) WHERE deleted_at IS NULL;
@@ -0,0 +1,7 @@
+ALTER TABLE products DROP CONSTRAINT IF EXISTS chk_products_confidence;
+ALTER TABLE products DROP COLUMN IF EXISTS confidence;
+
+ALTER TABLE causes DROP CONSTRAINT IF EXISTS chk_causes_weight;
+ALTER TABLE causes DROP CONSTRAINT IF EXISTS chk_causes_polarity;
+ALTER TABLE causes DROP COLUMN IF EXISTS weight;
+ALTER TABLE causes DROP COLUMN IF EXISTS polarity;
@@ -0,0 +1,22 @@
+-- Causes argue for (pro) or against (con) buying, with a weight from 1 to 5. Existing
+-- causes were all reasons to buy and become pros of average weight.
+ALTER TABLE causes ADD COLUMN polarity varchar(8) NOT NULL DEFAULT 'pro';
+ALTER TABLE causes ADD COLUMN weight smallint NOT NULL DEFAULT 3;
+ALTER TABLE causes ADD CONSTRAINT chk_causes_polarity CHECK (polarity IN ('pro', 'con'));
+ALTER TABLE causes ADD CONSTRAINT chk_causes_weight CHECK (weight BETWEEN 1 AND 5);
+
+-- The confidence score is kept on the product so lists can be ordered by it, it ranges
+-- from -1 (only cons) to 1 (only pros) and is 0 without active causes.
+ALTER TABLE products ADD COLUMN confidence double precision NOT NULL DEFAULT 0;
+ALTER TABLE products ADD CONSTRAINT chk_products_confidence CHECK (confidence BETWEEN -1 AND 1);
+
+UPDATE products p
+SET confidence = s.score
+FROM (
+    SELECT product_id,
+           SUM(CASE WHEN polarity = 'pro' THEN weight ELSE -weight END)::double precision / SUM(weight) AS score
+    FROM causes
+    WHERE status AND deleted_at IS NULL
+    GROUP BY product_id
+) s
+WHERE p.id = s.product_id;
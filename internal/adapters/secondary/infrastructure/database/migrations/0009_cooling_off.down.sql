@@ -0,0 +1,4 @@
+ALTER TABLE products DROP CONSTRAINT IF EXISTS chk_products_cooling_off_hours;
+ALTER TABLE products DROP COLUMN IF EXISTS cooling_off_hours;
+
+DROP TABLE IF EXISTS owner_settings;
@@ -0,0 +1,11 @@
+-- Owner wide defaults, a row only exists once the owner changed one.
+CREATE TABLE IF NOT EXISTS owner_settings (
+    owner_id bigint PRIMARY KEY,
+    cooling_off_hours integer NOT NULL DEFAULT 0 CHECK (cooling_off_hours BETWEEN 0 AND 8760),
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+-- A product's own cooling-off, NULL follows the owner's default.
+ALTER TABLE products ADD COLUMN cooling_off_hours integer;
+ALTER TABLE products ADD CONSTRAINT chk_products_cooling_off_hours CHECK (cooling_off_hours BETWEEN 0 AND 8760);
@@ -0,0 +1,6 @@
+-- Deleted plans would collide with the live plan of their product, they go first.
+DELETE FROM installment_payments WHERE plan_id IN (SELECT id FROM installment_plans WHERE deleted_at IS NOT NULL);
+DELETE FROM installment_plans WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_installment_plans_product_id;
+CREATE UNIQUE INDEX idx_installment_plans_product_id ON installment_plans (product_id);
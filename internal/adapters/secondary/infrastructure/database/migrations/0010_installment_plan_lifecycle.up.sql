@@ -0,0 +1,16 @@
+-- A deleted plan keeps its row, only the live plan of a product has to be unique so a new
+-- one can be set up after it.
+DROP INDEX IF EXISTS idx_installment_plans_product_id;
+CREATE UNIQUE INDEX idx_installment_plans_product_id ON installment_plans (product_id) WHERE deleted_at IS NULL;
+
+-- Plans of products deleted before they were removed along with them.
+UPDATE installment_payments pay
+SET deleted_at = p.deleted_at
+FROM installment_plans pl
+JOIN products p ON p.id = pl.product_id
+WHERE pay.plan_id = pl.id AND pay.deleted_at IS NULL AND p.deleted_at IS NOT NULL;
+
+UPDATE installment_plans pl
+SET deleted_at = p.deleted_at
+FROM products p
+WHERE p.id = pl.product_id AND pl.deleted_at IS NULL AND p.deleted_at IS NOT NULL;
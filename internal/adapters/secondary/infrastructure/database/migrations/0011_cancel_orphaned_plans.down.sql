@@ -0,0 +1,2 @@
+-- The cancelled plans stay cancelled, there is no telling them apart from others.
+SELECT 1;
@@ -0,0 +1,12 @@
+-- Products moved from installment back to pending kept their plan, which blocked a new
+-- one. Those plans are cancelled along with their payments.
+UPDATE installment_payments pay
+SET deleted_at = now()
+FROM installment_plans pl
+JOIN products p ON p.id = pl.product_id
+WHERE pay.plan_id = pl.id AND pay.deleted_at IS NULL AND pl.deleted_at IS NULL AND p.status = 'pending';
+
+UPDATE installment_plans pl
+SET deleted_at = now()
+FROM products p
+WHERE p.id = pl.product_id AND pl.deleted_at IS NULL AND p.status = 'pending';
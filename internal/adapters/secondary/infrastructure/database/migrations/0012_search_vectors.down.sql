@@ -0,0 +1,7 @@
+DROP AGGREGATE IF EXISTS tsvector_agg(tsvector);
+
+DROP INDEX IF EXISTS idx_causes_search_vector;
+ALTER TABLE causes DROP COLUMN IF EXISTS search_vector;
+
+DROP INDEX IF EXISTS idx_products_search_vector;
+ALTER TABLE products DROP COLUMN IF EXISTS search_vector;
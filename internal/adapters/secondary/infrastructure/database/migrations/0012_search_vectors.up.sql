@@ -0,0 +1,19 @@
+-- Search reads stored documents instead of building them per query, weighted the way
+-- results are ranked: name, then reasons, then link.
+ALTER TABLE products ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', name), 'A') ||
+    setweight(to_tsvector('simple', coalesce(link, '')), 'C')
+) STORED;
+CREATE INDEX IF NOT EXISTS idx_products_search_vector ON products USING gin (search_vector);
+
+ALTER TABLE causes ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', reason), 'B')
+) STORED;
+CREATE INDEX IF NOT EXISTS idx_causes_search_vector ON causes USING gin (search_vector);
+
+-- Joins the documents of a product's reasons into one.
+CREATE AGGREGATE tsvector_agg(tsvector) (
+    SFUNC = tsvector_concat,
+    STYPE = tsvector,
+    INITCOND = ''
+);
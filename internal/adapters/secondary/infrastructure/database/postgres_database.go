@@ -8,14 +8,15 @@ import (
 	"os"
 	"time"
 
-	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/repositories/cause"
-	. "github.com/zhunismp/intent-products-api/internal/adapters/secondary/repositories/product"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 	"gorm.io/plugin/opentelemetry/tracing"
 )
 
+// NewPostgresDatabase connects to postgres, it does not touch the schema. Migrations are
+// applied with the migrate command, see Migrator.
+//
 // TODO: update gorm to v2
 func NewPostgresDatabase(
 	host, user, password, dbname, port, sslmode, timezone string,
@@ -40,6 +41,7 @@ func NewPostgresDatabase(
 	gormConfig := &gorm.Config{
 		Logger:                                   gormLogger,
 		DisableForeignKeyConstraintWhenMigrating: false,
+		TranslateError:                           true,
 	}
 
 	gormDB, err := gorm.Open(postgres.Open(dsn), gormConfig)
@@ -62,13 +64,6 @@ func NewPostgresDatabase(
 
 	slog.Info("database connection established.")
 
-	if err := gormDB.AutoMigrate(
-		&ProductModel{},
-		&CauseModel{},
-	); err != nil {
-		return nil, func(ctx context.Context) error { return sqlDB.Close() }, fmt.Errorf("auto-migrate: %w", err)
-	}
-
 	shutdownFn := func(ctx context.Context) error {
 		done := make(chan error, 1)
 
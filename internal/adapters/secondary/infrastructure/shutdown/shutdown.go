@@ -16,6 +16,9 @@ type ShutdownFunction struct {
 type ShutdownManager interface {
 	Register(fn *ShutdownFunction)
 	Shutdown()
+	// Abort releases the registered resources like Shutdown but always exits non-zero, for a
+	// process that failed to start.
+	Abort()
 }
 
 type shutdownManagerImpl struct {
@@ -40,6 +43,26 @@ func (s *shutdownManagerImpl) Register(fn *ShutdownFunction) {
 func (s *shutdownManagerImpl) Shutdown() {
 	s.logger.Info("starting graceful shutdown...")
 
+	if err := s.cleanup(); err != nil {
+		s.logger.Error("shutdown completed with errors", slog.Any("errors", err))
+		os.Exit(1)
+	}
+
+	s.logger.Info("shutdown completed successfully")
+	os.Exit(0)
+}
+
+func (s *shutdownManagerImpl) Abort() {
+	s.logger.Info("aborting, releasing resources...")
+
+	if err := s.cleanup(); err != nil {
+		s.logger.Error("abort completed with errors", slog.Any("errors", err))
+	}
+	os.Exit(1)
+}
+
+// cleanup runs the registered functions in reverse order and joins their errors.
+func (s *shutdownManagerImpl) cleanup() error {
 	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
 	defer cancel()
 
@@ -61,12 +84,5 @@ func (s *shutdownManagerImpl) Shutdown() {
 		}
 	}
 
-	if len(errs) > 0 {
-		agg := errors.Join(errs...)
-		s.logger.Error("shutdown completed with errors", slog.Any("errors", agg))
-		os.Exit(1)
-	}
-
-	s.logger.Info("shutdown completed successfully")
-	os.Exit(0)
+	return errors.Join(errs...)
 }
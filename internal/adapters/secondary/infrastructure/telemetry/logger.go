@@ -50,3 +50,12 @@ func GetLogger(env, appName string) *slog.Logger {
 		return nil
 	}
 }
+
+// NewCommandLogger logs to stderr in text for the admin commands, stdout is left to
+// the output of the command itself.
+func NewCommandLogger() *slog.Logger {
+	stderrHandler := slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	})
+	return slog.New(slogctx.NewHandler(stderrHandler, nil))
+}
@@ -0,0 +1,93 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/MicahParks/keyfunc/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/auth"
+	"github.com/zhunismp/intent-products-api/internal/core/infrastructure/token"
+)
+
+// HS256 keys are symmetric "oct" entries of the key set, RS256 keys are "RSA" entries.
+var supportedAlgorithms = []string{jwt.SigningMethodHS256.Alg(), jwt.SigningMethodRS256.Alg()}
+
+type jwtClaims struct {
+	jwt.RegisteredClaims
+	Scope string `json:"scope,omitempty"`
+}
+
+type jwtVerifier struct {
+	keys   keyfunc.Keyfunc
+	parser *jwt.Parser
+}
+
+// NewJwtVerifier verifies tokens against the keys of a JWKS file or URL, the file wins
+// when both are given. With neither, every bearer token is rejected.
+func NewJwtVerifier(ctx context.Context, jwksFile, jwksURL, issuer, audience string) (token.Verifier, error) {
+	var keys keyfunc.Keyfunc
+	var err error
+
+	switch {
+	case jwksFile != "":
+		raw, readErr := os.ReadFile(jwksFile)
+		if readErr != nil {
+			return nil, fmt.Errorf("read jwks file: %w", readErr)
+		}
+		keys, err = keyfunc.NewJWKSetJSON(raw)
+	case jwksURL != "":
+		// keys are refreshed in the background until ctx is done
+		keys, err = keyfunc.NewDefaultCtx(ctx, []string{jwksURL})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load jwks: %w", err)
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(supportedAlgorithms),
+		jwt.WithExpirationRequired(),
+	}
+	if issuer != "" {
+		opts = append(opts, jwt.WithIssuer(issuer))
+	}
+	if audience != "" {
+		opts = append(opts, jwt.WithAudience(audience))
+	}
+
+	return &jwtVerifier{
+		keys:   keys,
+		parser: jwt.NewParser(opts...),
+	}, nil
+}
+
+func (v *jwtVerifier) Verify(ctx context.Context, tokenString string) (*auth.Principal, error) {
+	if v.keys == nil {
+		return nil, apperrors.New(apperrors.ErrCodeUnauthorized, "bearer authentication is not configured", nil)
+	}
+
+	claims := new(jwtClaims)
+	if _, err := v.parser.ParseWithClaims(tokenString, claims, v.keys.KeyfuncCtx(ctx)); err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, apperrors.New(apperrors.ErrCodeUnauthorized, "token is expired", err)
+		}
+		return nil, apperrors.New(apperrors.ErrCodeUnauthorized, "token is invalid", err)
+	}
+
+	userID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil || userID == 0 {
+		return nil, apperrors.New(apperrors.ErrCodeUnauthorized, "token subject is not a user id", err)
+	}
+
+	return &auth.Principal{
+		UserID:  uint(userID),
+		Subject: claims.Subject,
+		Method:  auth.MethodJWT,
+		Scopes:  strings.Fields(claims.Scope),
+	}, nil
+}
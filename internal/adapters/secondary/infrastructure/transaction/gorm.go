@@ -0,0 +1,39 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/zhunismp/intent-products-api/internal/core/infrastructure/transaction"
+	"gorm.io/gorm"
+)
+
+var _ transaction.TxManager = (*gormTxManager)(nil)
+
+type txKey struct{}
+
+type gormTxManager struct {
+	db *gorm.DB
+}
+
+func NewGormTxManager(db *gorm.DB) transaction.TxManager {
+	return &gormTxManager{db: db}
+}
+
+func (m *gormTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	// already inside a transaction, join it
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// FromContext returns the transaction carried by ctx, or db when there is none.
+func FromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
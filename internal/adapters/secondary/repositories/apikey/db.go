@@ -0,0 +1,103 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/transaction"
+	domain "github.com/zhunismp/intent-products-api/internal/core/domain/apikey"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"gorm.io/gorm"
+)
+
+type apiKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewApiKeyRepository(db *gorm.DB) domain.ApiKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+func (r *apiKeyRepository) CreateKey(ctx context.Context, key *domain.ApiKey) (*domain.ApiKey, error) {
+	model := toApiKeyModel(key)
+
+	if err := transaction.FromContext(ctx, r.db).Create(&model).Error; err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to create api key", err)
+	}
+
+	return toDomainApiKey(model), nil
+}
+
+func (r *apiKeyRepository) FindByOwnerID(ctx context.Context, ownerID uint) ([]*domain.ApiKey, error) {
+	var models []ApiKeyModel
+
+	err := transaction.FromContext(ctx, r.db).
+		Where("owner_id = ?", ownerID).
+		Order("id").
+		Find(&models).Error
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to find api keys", err)
+	}
+
+	result := make([]*domain.ApiKey, len(models))
+	for i, model := range models {
+		result[i] = toDomainApiKey(model)
+	}
+
+	return result, nil
+}
+
+func (r *apiKeyRepository) FindByPrefix(ctx context.Context, prefix string) (*domain.ApiKey, error) {
+	var model ApiKeyModel
+
+	err := transaction.FromContext(ctx, r.db).
+		Where("prefix = ?", prefix).
+		First(&model).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, "api key not found", err)
+	}
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to find api key", err)
+	}
+
+	return toDomainApiKey(model), nil
+}
+
+func (r *apiKeyRepository) RevokeKey(ctx context.Context, ownerID uint, keyID uint, revokedAt time.Time) error {
+	result := transaction.FromContext(ctx, r.db).
+		Model(&ApiKeyModel{}).
+		Where("id = ? AND owner_id = ? AND revoked_at IS NULL", keyID, ownerID).
+		Update("revoked_at", revokedAt)
+
+	if result.Error != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to revoke api key", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("no active api key found with id %d", keyID),
+			nil,
+		)
+	}
+
+	return nil
+}
+
+func (r *apiKeyRepository) UpdateLastUsed(ctx context.Context, keyID uint, usedAt time.Time) error {
+	err := transaction.FromContext(ctx, r.db).
+		Model(&ApiKeyModel{}).
+		Where("id = ?", keyID).
+		UpdateColumn("last_used_at", usedAt).Error
+
+	if err != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to update api key last used", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package apikey
+
+import (
+	"strings"
+	"time"
+
+	domain "github.com/zhunismp/intent-products-api/internal/core/domain/apikey"
+	"gorm.io/gorm"
+)
+
+type ApiKeyModel struct {
+	gorm.Model
+	OwnerID    uint   `gorm:"type:bigint;not null;index"`
+	Name       string `gorm:"type:text;not null"`
+	Prefix     string `gorm:"type:varchar(32);not null;uniqueIndex"`
+	Hash       string `gorm:"type:char(64);not null"`
+	Scopes     string `gorm:"type:text;not null"` // space separated
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+func (ApiKeyModel) TableName() string {
+	return "api_keys"
+}
+
+func toApiKeyModel(d *domain.ApiKey) ApiKeyModel {
+	return ApiKeyModel{
+		Model:      gorm.Model{ID: d.ID},
+		OwnerID:    d.OwnerID,
+		Name:       d.Name,
+		Prefix:     d.Prefix,
+		Hash:       d.Hash,
+		Scopes:     strings.Join(d.Scopes, " "),
+		LastUsedAt: d.LastUsedAt,
+		RevokedAt:  d.RevokedAt,
+	}
+}
+
+func toDomainApiKey(m ApiKeyModel) *domain.ApiKey {
+	return &domain.ApiKey{
+		ID:         m.ID,
+		OwnerID:    m.OwnerID,
+		Name:       m.Name,
+		Prefix:     m.Prefix,
+		Hash:       m.Hash,
+		Scopes:     strings.Fields(m.Scopes),
+		LastUsedAt: m.LastUsedAt,
+		RevokedAt:  m.RevokedAt,
+		CreatedAt:  m.CreatedAt,
+	}
+}
@@ -2,8 +2,12 @@ package cause
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
+	"github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/transaction"
 	domain "github.com/zhunismp/intent-products-api/internal/core/domain/cause"
 	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
 	"gorm.io/gorm"
@@ -27,7 +31,7 @@ func (r *causeRepository) BulkSaveCauses(ctx context.Context, productID uint, ca
 		models[i] = FromDomain(productID, c)
 	}
 
-	err := r.db.WithContext(ctx).Save(models).Error
+	err := transaction.FromContext(ctx, r.db).Save(models).Error
 	if err != nil {
 		return apperrors.New(apperrors.ErrCodeInternal, "failed to bulk save causes", err)
 	}
@@ -38,8 +42,9 @@ func (r *causeRepository) BulkSaveCauses(ctx context.Context, productID uint, ca
 func (r *causeRepository) FindByProductID(ctx context.Context, productID uint) ([]*domain.Cause, error) {
 	var models []*CauseModel
 
-	err := r.db.WithContext(ctx).
+	err := transaction.FromContext(ctx, r.db).
 		Where("product_id = ?", productID).
+		Order("position, id").
 		Find(&models).Error
 
 	if err != nil {
@@ -55,7 +60,7 @@ func (r *causeRepository) FindByProductID(ctx context.Context, productID uint) (
 }
 
 func (r *causeRepository) DeleteByProductID(ctx context.Context, productID uint) error {
-	result := r.db.WithContext(ctx).
+	result := transaction.FromContext(ctx, r.db).
 		Where("product_id = ?", productID).
 		Delete(&CauseModel{})
 
@@ -63,9 +68,105 @@ func (r *causeRepository) DeleteByProductID(ctx context.Context, productID uint)
 		return apperrors.New(apperrors.ErrCodeInternal, "failed to delete cause", result.Error)
 	}
 
+	// no rows affected is fine, a product may not have any causes
+	return nil
+}
+
+func (r *causeRepository) GetCause(ctx context.Context, productID, causeID uint) (*domain.Cause, error) {
+	var model CauseModel
+	err := transaction.FromContext(ctx, r.db).
+		Where("id = ? AND product_id = ?", causeID, productID).
+		First(&model).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, causeNotFoundError(productID, causeID, err)
+	}
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get cause", err)
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (r *causeRepository) UpdateCause(ctx context.Context, productID uint, cause *domain.Cause) (*domain.Cause, error) {
+	result := transaction.FromContext(ctx, r.db).
+		Model(&CauseModel{}).
+		Where("id = ? AND product_id = ?", cause.ID, productID).
+		Updates(map[string]any{
+			"reason":   cause.Reason,
+			"status":   cause.Status,
+			"polarity": cause.Polarity,
+			"weight":   cause.Weight,
+		})
+
+	if result.Error != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to update cause", result.Error)
+	}
 	if result.RowsAffected == 0 {
-		return apperrors.New(apperrors.ErrCodeNotFound, "no cause found", fmt.Errorf("no cause found for product %d", productID))
+		return nil, causeNotFoundError(productID, cause.ID, nil)
+	}
+
+	return r.GetCause(ctx, productID, cause.ID)
+}
+
+func (r *causeRepository) DeleteCause(ctx context.Context, productID, causeID uint) error {
+	result := transaction.FromContext(ctx, r.db).
+		Where("id = ? AND product_id = ?", causeID, productID).
+		Delete(&CauseModel{})
+
+	if result.Error != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to delete cause", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return causeNotFoundError(productID, causeID, nil)
+	}
+
+	return nil
+}
+
+func (r *causeRepository) GetLastPosition(ctx context.Context, productID uint) (string, error) {
+	var positions []string
+	err := transaction.FromContext(ctx, r.db).
+		Model(&CauseModel{}).
+		Where("product_id = ?", productID).
+		Order("position DESC").
+		Limit(1).
+		Pluck("position", &positions).Error
+
+	if err != nil {
+		return "", apperrors.New(apperrors.ErrCodeInternal, "failed to get last cause position", err)
+	}
+	if len(positions) == 0 {
+		return "", nil
+	}
+
+	return positions[0], nil
+}
+
+func (r *causeRepository) ReplacePositions(ctx context.Context, productID uint, positions map[uint]string) error {
+	db := transaction.FromContext(ctx, r.db)
+
+	for _, id := range slices.Sorted(maps.Keys(positions)) {
+		result := db.Model(&CauseModel{}).
+			Where("id = ? AND product_id = ?", id, productID).
+			Update("position", positions[id])
+
+		if result.Error != nil {
+			return apperrors.New(apperrors.ErrCodeInternal, "failed to update cause positions", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return causeNotFoundError(productID, id, nil)
+		}
 	}
 
 	return nil
 }
+
+func causeNotFoundError(productID, causeID uint, err error) error {
+	return apperrors.New(
+		apperrors.ErrCodeNotFound,
+		fmt.Sprintf("cause id %d not found for product id %d", causeID, productID),
+		err,
+	)
+}
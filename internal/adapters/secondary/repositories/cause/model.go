@@ -9,7 +9,10 @@ type CauseModel struct {
 	gorm.Model
 	ProductID uint   `gorm:"type:bigint;not null"`
 	Reason    string `gorm:"type:text;not null"`
-	Status    bool   `gorm:"not null;default:true"`
+	Status    bool   `gorm:"not null"` // no gorm default, it would turn an explicit false into true
+	Polarity  string `gorm:"type:varchar(8);not null"`
+	Weight    int    `gorm:"type:smallint;not null"`
+	Position  string `gorm:"type:varchar(255) COLLATE \"C\";not null"`
 }
 
 func (CauseModel) TableName() string {
@@ -21,6 +24,9 @@ func (m *CauseModel) ToDomain() *domain.Cause {
 		ID:     m.ID,
 		Reason: m.Reason,
 		Status: m.Status,
+		Polarity: m.Polarity,
+		Weight: m.Weight,
+		Position: m.Position,
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
 	}
@@ -32,5 +38,8 @@ func FromDomain(productID uint, d *domain.Cause) *CauseModel {
 		ProductID: productID,
 		Reason:    d.Reason,
 		Status:    d.Status,
+		Polarity:  d.Polarity,
+		Weight:    d.Weight,
+		Position:  d.Position,
 	}
 }
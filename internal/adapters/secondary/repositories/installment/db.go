@@ -0,0 +1,116 @@
+package installment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/transaction"
+	domain "github.com/zhunismp/intent-products-api/internal/core/domain/installment"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type installmentRepository struct {
+	db *gorm.DB
+}
+
+func NewInstallmentRepository(db *gorm.DB) domain.InstallmentRepository {
+	return &installmentRepository{db: db}
+}
+
+func (r *installmentRepository) CreatePlan(ctx context.Context, plan *domain.Plan) (uint, error) {
+	model := toPlanModel(plan)
+
+	if err := transaction.FromContext(ctx, r.db).Create(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return 0, apperrors.New(
+				apperrors.ErrCodeConflict,
+				fmt.Sprintf("product id %d already has an installment plan", plan.ProductID),
+				err,
+			)
+		}
+		return 0, apperrors.New(apperrors.ErrCodeInternal, "failed to create installment plan", err)
+	}
+
+	return model.ID, nil
+}
+
+func (r *installmentRepository) GetPlanByProductID(ctx context.Context, ownerID uint, productID uint) (*domain.Plan, error) {
+	return r.findPlan(transaction.FromContext(ctx, r.db), ownerID, productID)
+}
+
+func (r *installmentRepository) LockPlanByProductID(ctx context.Context, ownerID uint, productID uint) (*domain.Plan, error) {
+	db := transaction.FromContext(ctx, r.db).Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate})
+	return r.findPlan(db, ownerID, productID)
+}
+
+func (r *installmentRepository) findPlan(db *gorm.DB, ownerID uint, productID uint) (*domain.Plan, error) {
+	var model PlanModel
+	err := db.
+		Where("product_id = ? AND owner_id = ?", productID, ownerID).
+		First(&model).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("no installment plan found for product id %d", productID),
+			err,
+		)
+	}
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get installment plan", err)
+	}
+
+	return toDomainPlan(model), nil
+}
+
+func (r *installmentRepository) DeletePlansByProductID(ctx context.Context, productID uint) error {
+	db := transaction.FromContext(ctx, r.db)
+	plans := db.Model(&PlanModel{}).Select("id").Where("product_id = ?", productID)
+
+	if err := db.Where("plan_id IN (?)", plans).Delete(&PaymentModel{}).Error; err != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to delete installment payments", err)
+	}
+
+	if err := db.Where("product_id = ?", productID).Delete(&PlanModel{}).Error; err != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to delete installment plans", err)
+	}
+
+	return nil
+}
+
+func (r *installmentRepository) SavePayment(ctx context.Context, payment *domain.Payment) error {
+	model := toPaymentModel(payment)
+
+	if err := transaction.FromContext(ctx, r.db).Create(&model).Error; err != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to save installment payment", err)
+	}
+
+	payment.ID = model.ID
+	payment.CreatedAt = model.CreatedAt
+
+	return nil
+}
+
+func (r *installmentRepository) FindPaymentsByPlanID(ctx context.Context, planID uint) ([]*domain.Payment, error) {
+	var models []PaymentModel
+
+	err := transaction.FromContext(ctx, r.db).
+		Where("plan_id = ?", planID).
+		Order("paid_at, id").
+		Find(&models).Error
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to find installment payments by plan id", err)
+	}
+
+	payments := make([]*domain.Payment, 0, len(models))
+	for _, m := range models {
+		payments = append(payments, toDomainPayment(m))
+	}
+
+	return payments, nil
+}
@@ -0,0 +1,78 @@
+package installment
+
+import (
+	"time"
+
+	domain "github.com/zhunismp/intent-products-api/internal/core/domain/installment"
+	"gorm.io/gorm"
+)
+
+type PlanModel struct {
+	gorm.Model
+	ProductID    uint      `gorm:"type:bigint;not null;uniqueIndex:idx_installment_plans_product_id,where:deleted_at IS NULL"`
+	OwnerID      uint      `gorm:"type:bigint;not null"`
+	Total        float64   `gorm:"not null;check:total > 0"`
+	Months       int       `gorm:"not null;check:months > 0"`
+	StartDate    time.Time `gorm:"type:date;not null"`
+	InterestRate float64   `gorm:"not null;default:0;check:interest_rate >= 0"`
+}
+
+func (PlanModel) TableName() string {
+	return "installment_plans"
+}
+
+type PaymentModel struct {
+	gorm.Model
+	PlanID uint      `gorm:"type:bigint;not null;index"`
+	Amount float64   `gorm:"not null;check:amount > 0"`
+	PaidAt time.Time `gorm:"not null"`
+}
+
+func (PaymentModel) TableName() string {
+	return "installment_payments"
+}
+
+func toPlanModel(d *domain.Plan) PlanModel {
+	return PlanModel{
+		Model:        gorm.Model{ID: d.ID},
+		ProductID:    d.ProductID,
+		OwnerID:      d.OwnerID,
+		Total:        d.Total,
+		Months:       d.Months,
+		StartDate:    d.StartDate,
+		InterestRate: d.InterestRate,
+	}
+}
+
+func toDomainPlan(m PlanModel) *domain.Plan {
+	return &domain.Plan{
+		ID:           m.ID,
+		ProductID:    m.ProductID,
+		OwnerID:      m.OwnerID,
+		Total:        m.Total,
+		Months:       m.Months,
+		StartDate:    m.StartDate,
+		InterestRate: m.InterestRate,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+func toPaymentModel(d *domain.Payment) PaymentModel {
+	return PaymentModel{
+		Model:  gorm.Model{ID: d.ID},
+		PlanID: d.PlanID,
+		Amount: d.Amount,
+		PaidAt: d.PaidAt,
+	}
+}
+
+func toDomainPayment(m PaymentModel) *domain.Payment {
+	return &domain.Payment{
+		ID:        m.ID,
+		PlanID:    m.PlanID,
+		Amount:    m.Amount,
+		PaidAt:    m.PaidAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
+	"github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/transaction"
 	domain "github.com/zhunismp/intent-products-api/internal/core/domain/product"
 	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
 	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/utils/ordering"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type productRepository struct {
@@ -21,13 +25,13 @@ func NewProductRepository(db *gorm.DB) domain.ProductRepository {
 
 func (r *productRepository) CreateProduct(ctx context.Context, product *domain.Product) (uint, error) {
 	// Get the last position to append the new product at the end
-	lastPosition, err := r.GetLastPosition(ctx, product.OwnerID)
+	lastPosition, err := r.GetLastPosition(ctx, product.ListID, 0)
 	if err != nil {
 		return 0, err
 	}
 
 	// Generate new position after the last item
-	newPosition, err := ordering.KeyBetween(lastPosition, "")
+	newPosition, err := ordering.JitteredKeyBetween(lastPosition, "", domain.PositionSpread)
 	if err != nil {
 		return 0, apperrors.New(
 			apperrors.ErrCodeInternal,
@@ -39,13 +43,9 @@ func (r *productRepository) CreateProduct(ctx context.Context, product *domain.P
 	product.Position = newPosition
 	model := toProductModel(product)
 
-	if err := r.db.WithContext(ctx).Save(&model).Error; err != nil {
+	if err := transaction.FromContext(ctx, r.db).Save(&model).Error; err != nil {
 		if errors.Is(err, gorm.ErrDuplicatedKey) {
-			return 0, apperrors.New(
-				apperrors.ErrCodeForbidden,
-				fmt.Sprintf("owner id %d attempted to create existing product name '%s'", product.OwnerID, product.Name),
-				err,
-			)
+			return 0, positionTakenError(product.ListID, err)
 		}
 		return 0, apperrors.New(apperrors.ErrCodeInternal, "failed to create product", err)
 	}
@@ -55,7 +55,7 @@ func (r *productRepository) CreateProduct(ctx context.Context, product *domain.P
 
 func (r *productRepository) GetProduct(ctx context.Context, ownerID uint, productID uint) (*domain.Product, error) {
 	var model ProductModel
-	err := r.db.WithContext(ctx).
+	err := transaction.FromContext(ctx, r.db).
 		Where("id = ? AND owner_id = ?", productID, ownerID).
 		First(&model).Error
 
@@ -75,14 +75,10 @@ func (r *productRepository) GetProduct(ctx context.Context, ownerID uint, produc
 }
 
 func (r *productRepository) FindAllProducts(ctx context.Context, ownerID uint, filter *domain.Filter) ([]*domain.Product, error) {
-	q := r.db.WithContext(ctx).
-		Where("owner_id = ?", ownerID).
-		Order("position")
+	q := transaction.FromContext(ctx, r.db).
+		Where("owner_id = ?", ownerID)
 
-	// TODO: extract logic away from here
-	if filter.Status != "" {
-		q = q.Where("status = ?", filter.Status)
-	}
+	q = applySort(applyFilter(q, filter), filter)
 
 	offset := (filter.Page - 1) * filter.Size
 	q = q.Offset(offset).Limit(filter.Size)
@@ -93,7 +89,7 @@ func (r *productRepository) FindAllProducts(ctx context.Context, ownerID uint, f
 	if err != nil {
 		return nil, apperrors.New(
 			apperrors.ErrCodeInternal,
-			"failed to get products by status",
+			"failed to get products",
 			err,
 		)
 	}
@@ -106,8 +102,236 @@ func (r *productRepository) FindAllProducts(ctx context.Context, ownerID uint, f
 	return products, nil
 }
 
+func (r *productRepository) FindAllByOwner(ctx context.Context, ownerID uint) ([]*domain.Product, error) {
+	var models []ProductModel
+	err := transaction.FromContext(ctx, r.db).
+		Where("owner_id = ?", ownerID).
+		Order("list_id, position, id").
+		Find(&models).Error
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get products", err)
+	}
+
+	products := make([]*domain.Product, 0, len(models))
+	for _, m := range models {
+		products = append(products, toDomainProduct(m))
+	}
+
+	return products, nil
+}
+
+func (r *productRepository) FindAllByList(ctx context.Context, listID uint) ([]*domain.Product, error) {
+	var models []ProductModel
+	err := transaction.FromContext(ctx, r.db).
+		Where("list_id = ?", listID).
+		Order("position, id").
+		Find(&models).Error
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get products", err)
+	}
+
+	products := make([]*domain.Product, 0, len(models))
+	for _, m := range models {
+		products = append(products, toDomainProduct(m))
+	}
+
+	return products, nil
+}
+
+func (r *productRepository) CountProducts(ctx context.Context, ownerID uint, filter *domain.Filter) (int64, error) {
+	q := transaction.FromContext(ctx, r.db).
+		Model(&ProductModel{}).
+		Where("owner_id = ?", ownerID)
+
+	q = applyFilter(q, filter)
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return 0, apperrors.New(apperrors.ErrCodeInternal, "failed to count products", err)
+	}
+
+	return total, nil
+}
+
+func (r *productRepository) SummarizeByStatus(ctx context.Context, ownerID uint) ([]*domain.StatusSummary, error) {
+	var rows []struct {
+		Status     string
+		Count      int64
+		TotalPrice float64
+	}
+
+	err := transaction.FromContext(ctx, r.db).
+		Model(&ProductModel{}).
+		Select("status, COUNT(*) AS count, COALESCE(SUM(price), 0) AS total_price").
+		Where("owner_id = ?", ownerID).
+		Group("status").
+		Scan(&rows).Error
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to summarize products", err)
+	}
+
+	result := make([]*domain.StatusSummary, len(rows))
+	for i, row := range rows {
+		result[i] = &domain.StatusSummary{
+			Status:     row.Status,
+			Count:      row.Count,
+			TotalPrice: row.TotalPrice,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *productRepository) SummarizeByTag(ctx context.Context, ownerID uint) ([]*domain.TagSummary, error) {
+	var rows []struct {
+		TagID      uint
+		Name       string
+		Count      int64
+		TotalPrice float64
+	}
+
+	// tags without products still get a row, links of deleted products do not count
+	err := transaction.FromContext(ctx, r.db).
+		Table("tags").
+		Select("tags.id AS tag_id, tags.name, COUNT(products.id) AS count, COALESCE(SUM(products.price), 0) AS total_price").
+		Joins("LEFT JOIN product_tags ON product_tags.tag_id = tags.id").
+		Joins("LEFT JOIN products ON products.id = product_tags.product_id AND products.deleted_at IS NULL").
+		Where("tags.owner_id = ? AND tags.deleted_at IS NULL", ownerID).
+		Group("tags.id, tags.name").
+		Order("lower(tags.name), tags.id").
+		Scan(&rows).Error
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to summarize products by tag", err)
+	}
+
+	result := make([]*domain.TagSummary, len(rows))
+	for i, row := range rows {
+		result[i] = &domain.TagSummary{
+			TagID:      row.TagID,
+			Name:       row.Name,
+			Count:      row.Count,
+			TotalPrice: row.TotalPrice,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *productRepository) FindProductsPage(
+	ctx context.Context,
+	ownerID uint,
+	filter *domain.Filter,
+	cursor *domain.Cursor,
+	limit int,
+) ([]*domain.Product, error) {
+	q := transaction.FromContext(ctx, r.db).
+		Where("owner_id = ?", ownerID)
+
+	q = applyFilter(q, filter)
+
+	backward := cursor != nil && cursor.Direction == domain.DirectionPrev
+	switch {
+	case cursor == nil:
+		q = q.Order("position, id")
+	case backward:
+		q = q.Where("(position, id) < (?, ?)", cursor.Position, cursor.ID).Order("position DESC, id DESC")
+	default:
+		q = q.Where("(position, id) > (?, ?)", cursor.Position, cursor.ID).Order("position, id")
+	}
+
+	var models []ProductModel
+	if err := q.Limit(limit).Find(&models).Error; err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get products page", err)
+	}
+
+	// walking backward reads the rows in reverse, flip them back to position order
+	if backward {
+		slices.Reverse(models)
+	}
+
+	products := make([]*domain.Product, 0, len(models))
+	for _, m := range models {
+		products = append(products, toDomainProduct(m))
+	}
+
+	return products, nil
+}
+
+func (r *productRepository) UpdateProduct(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	result := transaction.FromContext(ctx, r.db).
+		Model(&ProductModel{}).
+		Where("id = ? AND owner_id = ?", product.ID, product.OwnerID).
+		Updates(map[string]any{
+			"name":      product.Name,
+			"image_url": product.ImageUrl,
+			"link":      product.Link,
+			"price":     product.Price,
+			"status":    product.Status,
+
+			"cooling_off_hours": product.CoolingOffHours,
+		})
+
+	if result.Error != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to update product", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("owner id %d does not own product id %d", product.OwnerID, product.ID),
+			nil,
+		)
+	}
+
+	return r.GetProduct(ctx, product.OwnerID, product.ID)
+}
+
+func (r *productRepository) UpdateStatus(ctx context.Context, ownerID uint, productID uint, from string, to string) error {
+	// only move the product when nobody changed its status in the meantime
+	result := transaction.FromContext(ctx, r.db).
+		Model(&ProductModel{}).
+		Where("id = ? AND owner_id = ? AND status = ?", productID, ownerID, from).
+		Update("status", to)
+
+	if result.Error != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to update status", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.New(
+			apperrors.ErrCodeConflict,
+			fmt.Sprintf("product id %d is no longer in status '%s'", productID, from),
+			nil,
+		)
+	}
+
+	return nil
+}
+
+func (r *productRepository) UpdateConfidence(ctx context.Context, ownerID uint, productID uint, confidence float64) error {
+	result := transaction.FromContext(ctx, r.db).
+		Model(&ProductModel{}).
+		Where("id = ? AND owner_id = ?", productID, ownerID).
+		Update("confidence", confidence)
+
+	if result.Error != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to update confidence", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("product id %d not found for owner id %d", productID, ownerID),
+			nil,
+		)
+	}
+
+	return nil
+}
+
 func (r *productRepository) DeleteProduct(ctx context.Context, ownerID uint, productID uint) error {
-	result := r.db.WithContext(ctx).
+	result := transaction.FromContext(ctx, r.db).
 		Where("id = ? AND owner_id = ?", productID, ownerID).
 		Delete(&ProductModel{})
 
@@ -124,26 +348,25 @@ func (r *productRepository) DeleteProduct(ctx context.Context, ownerID uint, pro
 	return nil
 }
 
-func (r *productRepository) GetFirstPosition(ctx context.Context, ownerID uint) (string, error) {
-	var position string
-
-	err := r.db.WithContext(ctx).
+// neighbours scopes a position lookup to the live products of the list other than excludeID.
+func (r *productRepository) neighbours(ctx context.Context, listID uint, excludeID uint) *gorm.DB {
+	return transaction.FromContext(ctx, r.db).
 		Table("products").
 		Select("position").
-		Where("owner_id = ?", ownerID).
-		Order("position").
+		Where("list_id = ? AND id <> ? AND deleted_at IS NULL", listID, excludeID)
+}
+
+func (r *productRepository) GetFirstPosition(ctx context.Context, listID uint, excludeID uint) (string, error) {
+	var position string
+
+	err := r.neighbours(ctx, listID, excludeID).
+		Order("position COLLATE \"C\" ASC").
 		Limit(1).
 		Pluck("position", &position).
 		Error
 
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return "", apperrors.New(
-				apperrors.ErrCodeNotFound,
-				fmt.Sprintf("no products found for owner id %d", ownerID),
-				err,
-			)
-		}
+	// No first position means the list is empty apart from the excluded product
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return "", apperrors.New(
 			apperrors.ErrCodeInternal,
 			"failed to get first position",
@@ -151,24 +374,13 @@ func (r *productRepository) GetFirstPosition(ctx context.Context, ownerID uint)
 		)
 	}
 
-	if position == "" {
-		return "", apperrors.New(
-			apperrors.ErrCodeNotFound,
-			fmt.Sprintf("no products found for owner id %d", ownerID),
-			nil,
-		)
-	}
-
 	return position, nil
 }
 
-func (r *productRepository) GetLastPosition(ctx context.Context, ownerID uint) (string, error) {
+func (r *productRepository) GetLastPosition(ctx context.Context, listID uint, excludeID uint) (string, error) {
 	var position string
-	err := r.db.WithContext(ctx).
-		Table("products").
-		Select("position").
-		Where("owner_id = ?", ownerID).
-		Order("position DESC").
+	err := r.neighbours(ctx, listID, excludeID).
+		Order("position COLLATE \"C\" DESC").
 		Limit(1).
 		Pluck("position", &position).
 		Error
@@ -185,77 +397,86 @@ func (r *productRepository) GetLastPosition(ctx context.Context, ownerID uint) (
 	return position, nil
 }
 
-func (r *productRepository) GetPositionByProductID(ctx context.Context, ownerID uint, productID uint) (string, error) {
-	var position string
+func (r *productRepository) GetNextPosition(ctx context.Context, listID uint, excludeID uint, position string) (string, error) {
+	var nextPosition string
 
-	err := r.db.WithContext(ctx).
-		Table("products").
-		Select("position").
-		Where("id = ? AND owner_id = ?", productID, ownerID).
-		Pluck("position", &position).
+	err := r.neighbours(ctx, listID, excludeID).
+		Where("position COLLATE \"C\" > ?", position).
+		Order("position COLLATE \"C\" ASC").
+		Limit(1).
+		Pluck("position", &nextPosition).
 		Error
 
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return "", apperrors.New(
-				apperrors.ErrCodeNotFound,
-				fmt.Sprintf("product id %d not found for owner id %d", productID, ownerID),
-				err,
-			)
+			// No next position means this is the last item - return empty string
+			return "", nil
 		}
 		return "", apperrors.New(
 			apperrors.ErrCodeInternal,
-			"failed to get position by product id",
+			"failed to get next position",
 			err,
 		)
 	}
 
-	if position == "" {
+	// Empty result means no next item (last item in list)
+	return nextPosition, nil
+}
+
+func (r *productRepository) GetPrevPosition(ctx context.Context, listID uint, excludeID uint, position string) (string, error) {
+	var prevPosition string
+
+	err := r.neighbours(ctx, listID, excludeID).
+		Where("position COLLATE \"C\" < ?", position).
+		Order("position COLLATE \"C\" DESC").
+		Limit(1).
+		Pluck("position", &prevPosition).
+		Error
+
+	// Empty result means no previous item (first item in list)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return "", apperrors.New(
-			apperrors.ErrCodeNotFound,
-			fmt.Sprintf("product id %d not found for owner id %d", productID, ownerID),
-			nil,
+			apperrors.ErrCodeInternal,
+			"failed to get previous position",
+			err,
 		)
 	}
 
-	return position, nil
+	return prevPosition, nil
 }
 
-func (r *productRepository) GetNextPosition(ctx context.Context, ownerID uint, position string) (string, error) {
-	var nextPosition string
+func (r *productRepository) GetPositionAt(ctx context.Context, listID uint, excludeID uint, index int) (string, error) {
+	var position string
 
-	err := r.db.WithContext(ctx).
-		Table("products").
-		Select("position").
-		Where("owner_id = ? AND position > ?", ownerID, position).
+	err := r.neighbours(ctx, listID, excludeID).
 		Order("position COLLATE \"C\" ASC").
+		Offset(index).
 		Limit(1).
-		Pluck("position", &nextPosition).
+		Pluck("position", &position).
 		Error
 
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			// No next position means this is the last item - return empty string
-			return "", nil
-		}
+	// Empty result means the index is past the end of the list
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return "", apperrors.New(
 			apperrors.ErrCodeInternal,
-			"failed to get next position",
+			"failed to get position at index",
 			err,
 		)
 	}
 
-	// Empty result means no next item (last item in list)
-	return nextPosition, nil
+	return position, nil
 }
 
-func (r *productRepository) UpdatePosition(ctx context.Context, ownerID uint, productID uint, position string) error {
-	result := r.db.WithContext(ctx).
+func (r *productRepository) UpdatePosition(ctx context.Context, ownerID uint, productID uint, listID uint, position string) error {
+	result := transaction.FromContext(ctx, r.db).
 		Table("products").
 		Where("id = ? AND owner_id = ?", productID, ownerID).
-		Update("position", position)
+		Updates(map[string]any{"list_id": listID, "position": position})
 
 	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return positionTakenError(listID, result.Error)
+		}
 		return apperrors.New(
 			apperrors.ErrCodeInternal,
 			"failed to update position",
@@ -274,23 +495,58 @@ func (r *productRepository) UpdatePosition(ctx context.Context, ownerID uint, pr
 	return nil
 }
 
-func (r *productRepository) ValidateOwnership(ctx context.Context, ownerID, productID uint) error {
-	var count int64
-	err := r.db.WithContext(ctx).
-		Table("products").
-		Where("id = ? AND owner_id = ?", productID, ownerID).
-		Count(&count).
-		Error
+func (r *productRepository) ReplacePositions(ctx context.Context, listID uint, positions map[uint]string) error {
+	if len(positions) == 0 {
+		return nil
+	}
+
+	db := transaction.FromContext(ctx, r.db)
+	ids := slices.Sorted(maps.Keys(positions))
 
+	// park the rows on keys no real position can have first, the unique index is checked
+	// row by row so handing keys around directly could trip over a key not moved yet
+	err := db.Table("products").
+		Where("list_id = ? AND id IN ?", listID, ids).
+		Update("position", gorm.Expr("'~' || id::text")).Error
 	if err != nil {
-		return apperrors.New(
-			apperrors.ErrCodeInternal,
-			"failed to validate ownership",
-			err,
-		)
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to update positions", err)
 	}
 
-	if count == 0 {
+	for _, id := range ids {
+		result := db.Table("products").
+			Where("id = ? AND list_id = ?", id, listID).
+			Update("position", positions[id])
+
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+				return positionTakenError(listID, result.Error)
+			}
+			return apperrors.New(apperrors.ErrCodeInternal, "failed to update positions", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return apperrors.New(
+				apperrors.ErrCodeNotFound,
+				fmt.Sprintf("product id %d not found in list id %d", id, listID),
+				nil,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (r *productRepository) LockProduct(ctx context.Context, ownerID uint, productID uint) error {
+	var ids []uint
+	err := transaction.FromContext(ctx, r.db).
+		Model(&ProductModel{}).
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Where("id = ? AND owner_id = ?", productID, ownerID).
+		Pluck("id", &ids).Error
+
+	if err != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to lock product", err)
+	}
+	if len(ids) == 0 {
 		return apperrors.New(
 			apperrors.ErrCodeNotFound,
 			fmt.Sprintf("product id %d not found for owner id %d", productID, ownerID),
@@ -300,3 +556,28 @@ func (r *productRepository) ValidateOwnership(ctx context.Context, ownerID, prod
 
 	return nil
 }
+
+func (r *productRepository) LockPositions(ctx context.Context, listID uint) error {
+	var ids []uint
+	// always lock in id order so two lockers can not deadlock each other
+	err := transaction.FromContext(ctx, r.db).
+		Model(&ProductModel{}).
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Where("list_id = ?", listID).
+		Order("id").
+		Pluck("id", &ids).Error
+
+	if err != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to lock positions", err)
+	}
+
+	return nil
+}
+
+func positionTakenError(listID uint, err error) error {
+	return apperrors.New(
+		apperrors.ErrCodeConflict,
+		fmt.Sprintf("position is already taken by another product of list id %d, try again", listID),
+		fmt.Errorf("%w: %w", domain.ErrPositionTaken, err),
+	)
+}
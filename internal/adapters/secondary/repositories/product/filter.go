@@ -0,0 +1,94 @@
+package product
+
+import (
+	"strings"
+
+	domain "github.com/zhunismp/intent-products-api/internal/core/domain/product"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// sortColumns maps the sort fields of the domain to columns, anything else never reaches SQL
+var sortColumns = map[string]string{
+	domain.SortPosition:   "position",
+	domain.SortPrice:      "price",
+	domain.SortCreatedAt:  "created_at",
+	domain.SortName:       "name",
+	domain.SortConfidence: "confidence",
+}
+
+const taggedQuery = "EXISTS (SELECT 1 FROM product_tags WHERE product_tags.product_id = products.id AND product_tags.tag_id IN ?)"
+
+const activeCausesQuery = "EXISTS (SELECT 1 FROM causes WHERE causes.product_id = products.id AND causes.status AND causes.deleted_at IS NULL)"
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// applyFilter narrows q to the products matching the criteria of filter, values are always bound
+func applyFilter(q *gorm.DB, filter *domain.Filter) *gorm.DB {
+	if filter == nil {
+		return q
+	}
+
+	if filter.ListID != nil {
+		q = q.Where("list_id = ?", *filter.ListID)
+	}
+
+	if len(filter.TagIDs) > 0 {
+		q = q.Where(taggedQuery, filter.TagIDs)
+	}
+
+	if len(filter.Statuses) > 0 {
+		q = q.Where("status IN ?", filter.Statuses)
+	}
+
+	if filter.MinPrice != nil {
+		q = q.Where("price >= ?", *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		q = q.Where("price <= ?", *filter.MaxPrice)
+	}
+
+	if filter.CreatedFrom != nil {
+		q = q.Where("created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		q = q.Where("created_at <= ?", *filter.CreatedTo)
+	}
+	if filter.UpdatedFrom != nil {
+		q = q.Where("updated_at >= ?", *filter.UpdatedFrom)
+	}
+	if filter.UpdatedTo != nil {
+		q = q.Where("updated_at <= ?", *filter.UpdatedTo)
+	}
+
+	if filter.Name != "" {
+		q = q.Where("name ILIKE ?", "%"+likeEscaper.Replace(filter.Name)+"%")
+	}
+
+	if filter.HasActiveCauses != nil {
+		if *filter.HasActiveCauses {
+			q = q.Where(activeCausesQuery)
+		} else {
+			q = q.Where("NOT " + activeCausesQuery)
+		}
+	}
+
+	return q
+}
+
+// applySort orders q by the sort of filter, id breaks ties so pages are stable
+func applySort(q *gorm.DB, filter *domain.Filter) *gorm.DB {
+	column := sortColumns[domain.SortPosition]
+	desc := false
+	if filter != nil {
+		if c, ok := sortColumns[filter.SortBy]; ok {
+			column = c
+		}
+		desc = filter.SortDesc
+	}
+
+	return q.Order(clause.OrderBy{Columns: []clause.OrderByColumn{
+		{Column: clause.Column{Name: column}, Desc: desc},
+		{Column: clause.Column{Name: "id"}, Desc: desc},
+	}})
+}
@@ -1,6 +1,8 @@
 package product
 
 import (
+	"time"
+
 	domain "github.com/zhunismp/intent-products-api/internal/core/domain/product"
 	"gorm.io/gorm"
 )
@@ -8,12 +10,16 @@ import (
 type ProductModel struct {
 	gorm.Model
 	OwnerID  uint    `gorm:"type:bigint;not null"`
+	ListID   uint    `gorm:"type:bigint;not null"`
 	Name     string  `gorm:"type:varchar(255);not null"`
 	ImageURL string  `gorm:"type:text"`
 	Link     string  `gorm:"type:text"`
 	Price    float64 `gorm:"not null;check:price >= 0"`
-	Status   string  `gorm:"type:varchar(50);not null;default:'active'"`
+	Status   string  `gorm:"type:varchar(50);not null;default:'pending'"`
 	Position string  `gorm:"type:varchar(255) COLLATE \"C\";not null"` // ensure binary order
+
+	Confidence      float64 `gorm:"not null"`
+	CoolingOffHours *int
 }
 
 func (ProductModel) TableName() string {
@@ -24,12 +30,16 @@ func toProductModel(d *domain.Product) ProductModel {
 	return ProductModel{
 		Model:    gorm.Model{ID: d.ID},
 		OwnerID:  d.OwnerID,
+		ListID:   d.ListID,
 		Name:     d.Name,
 		ImageURL: d.ImageUrl,
 		Link:     d.Link,
 		Price:    d.Price,
 		Status:   d.Status,
 		Position: d.Position,
+
+		Confidence:      d.Confidence,
+		CoolingOffHours: d.CoolingOffHours,
 	}
 }
 
@@ -37,6 +47,7 @@ func toDomainProduct(m ProductModel) *domain.Product {
 	return &domain.Product{
 		ID:        m.ID,
 		OwnerID:   m.OwnerID,
+		ListID:    m.ListID,
 		Name:      m.Name,
 		ImageUrl:  m.ImageURL,
 		Link:      m.Link,
@@ -45,5 +56,43 @@ func toDomainProduct(m ProductModel) *domain.Product {
 		Position:  m.Position,
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
+
+		Confidence:      m.Confidence,
+		CoolingOffHours: m.CoolingOffHours,
+	}
+}
+
+type StatusHistoryModel struct {
+	ID         uint      `gorm:"primaryKey"`
+	ProductID  uint      `gorm:"type:bigint;not null;index"`
+	ChangedBy  uint      `gorm:"type:bigint;not null"`
+	FromStatus string    `gorm:"type:varchar(50);not null"`
+	ToStatus   string    `gorm:"type:varchar(50);not null"`
+	ChangedAt  time.Time `gorm:"not null;autoCreateTime"`
+}
+
+func (StatusHistoryModel) TableName() string {
+	return "product_status_history"
+}
+
+func toStatusHistoryModel(d *domain.StatusChange) StatusHistoryModel {
+	return StatusHistoryModel{
+		ID:         d.ID,
+		ProductID:  d.ProductID,
+		ChangedBy:  d.ChangedBy,
+		FromStatus: d.FromStatus,
+		ToStatus:   d.ToStatus,
+		ChangedAt:  d.ChangedAt,
+	}
+}
+
+func toDomainStatusChange(m StatusHistoryModel) *domain.StatusChange {
+	return &domain.StatusChange{
+		ID:         m.ID,
+		ProductID:  m.ProductID,
+		ChangedBy:  m.ChangedBy,
+		FromStatus: m.FromStatus,
+		ToStatus:   m.ToStatus,
+		ChangedAt:  m.ChangedAt,
 	}
 }
@@ -0,0 +1,135 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/transaction"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/search"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"gorm.io/gorm"
+)
+
+// the simple configuration does not stem, wishlists mix languages and brand names
+// that an english dictionary would mangle
+//
+// every term must match somewhere across the name, the link and the reasons. The stored
+// documents are indexed per table, so candidates are the products where any term matches
+// in one of them, and only those get their documents joined to check every term
+//
+// the text is HTML escaped before ts_headline marks it, the parser reads the escapes as
+// entities which are never matched, so only the marks are markup in a snippet
+var searchQuery = `
+WITH q AS (
+	SELECT to_tsquery('simple', @query) AS query, to_tsquery('simple', @anyQuery) AS any_query
+), candidates AS (
+	SELECT products.id FROM products, q
+	WHERE products.owner_id = @owner AND products.deleted_at IS NULL AND products.search_vector @@ q.any_query
+	UNION
+	SELECT products.id FROM products JOIN causes ON causes.product_id = products.id, q
+	WHERE products.owner_id = @owner AND products.deleted_at IS NULL
+		AND causes.deleted_at IS NULL AND causes.search_vector @@ q.any_query
+)
+SELECT products.*,
+	ts_rank(d.document, q.query) AS rank,
+	ts_headline('simple', ` + escapeHTML("products.name") + `, q.query, @whole) AS name_snippet,
+	ts_headline('simple', ` + escapeHTML("coalesce(products.link, '')") + `, q.query, @whole) AS link_snippet,
+	ts_headline('simple', ` + escapeHTML("coalesce(r.matched, '')") + `, q.query, @fragments) AS reason_snippet
+FROM candidates
+JOIN products ON products.id = candidates.id
+CROSS JOIN q
+LEFT JOIN LATERAL (
+	SELECT tsvector_agg(causes.search_vector) AS document,
+		string_agg(causes.reason, ' ... ' ORDER BY causes.id) FILTER (WHERE causes.search_vector @@ q.query) AS matched
+	FROM causes
+	WHERE causes.product_id = products.id AND causes.deleted_at IS NULL
+) r ON true
+CROSS JOIN LATERAL (
+	SELECT products.search_vector || coalesce(r.document, ''::tsvector) AS document
+) d
+WHERE d.document @@ q.query
+ORDER BY rank DESC, products.id
+LIMIT @limit`
+
+const (
+	headlineWhole     = "StartSel=" + search.HighlightStart + ", StopSel=" + search.HighlightStop + ", HighlightAll=true"
+	headlineFragments = "StartSel=" + search.HighlightStart + ", StopSel=" + search.HighlightStop + ", MaxFragments=3, MaxWords=20, MinWords=5, FragmentDelimiter=\" ... \""
+)
+
+type searchRow struct {
+	ProductModel
+	Rank          float64
+	NameSnippet   string
+	LinkSnippet   string
+	ReasonSnippet string
+}
+
+type searchRepository struct {
+	db *gorm.DB
+}
+
+// NewSearchRepository searches products with postgres full-text search.
+func NewSearchRepository(db *gorm.DB) search.SearchRepository {
+	return &searchRepository{db: db}
+}
+
+func (r *searchRepository) SearchProducts(ctx context.Context, ownerID uint, query *search.SearchQuery) ([]*search.SearchResult, error) {
+	var rows []searchRow
+	err := transaction.FromContext(ctx, r.db).
+		Raw(searchQuery, map[string]any{
+			"query":     toTsQuery(query.Terms, " & "),
+			"anyQuery":  toTsQuery(query.Terms, " | "),
+			"whole":     headlineWhole,
+			"fragments": headlineFragments,
+			"owner":     ownerID,
+			"limit":     query.Limit,
+		}).
+		Scan(&rows).Error
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to search products", err)
+	}
+
+	results := make([]*search.SearchResult, 0, len(rows))
+	for _, row := range rows {
+		result := &search.SearchResult{
+			Product:    toDomainProduct(row.ProductModel),
+			Rank:       row.Rank,
+			Highlights: []*search.SearchHighlight{},
+		}
+
+		// ts_headline returns the start of the text when nothing matched in it
+		for _, h := range []search.SearchHighlight{
+			{Field: search.FieldName, Snippet: row.NameSnippet},
+			{Field: search.FieldReason, Snippet: row.ReasonSnippet},
+			{Field: search.FieldLink, Snippet: row.LinkSnippet},
+		} {
+			if strings.Contains(h.Snippet, search.HighlightStart) {
+				result.Highlights = append(result.Highlights, &h)
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// escapeHTML wraps a text expression so it escapes the same characters as html.EscapeString
+func escapeHTML(expr string) string {
+	for _, r := range [][2]string{{"&", "&amp;"}, {"<", "&lt;"}, {">", "&gt;"}, {`"`, "&#34;"}, {"'", "&#39;"}} {
+		expr = fmt.Sprintf("replace(%s, '%s', '%s')", expr, strings.ReplaceAll(r[0], "'", "''"), r[1])
+	}
+	return expr
+}
+
+// toTsQuery matches words starting with the terms joined by op, terms only hold letters
+// and digits so they can not inject tsquery operators
+func toTsQuery(terms []string, op string) string {
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		parts[i] = term + ":*"
+	}
+	return strings.Join(parts, op)
+}
@@ -0,0 +1,51 @@
+package product
+
+import (
+	"context"
+
+	"github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/transaction"
+	domain "github.com/zhunismp/intent-products-api/internal/core/domain/product"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"gorm.io/gorm"
+)
+
+type statusHistoryRepository struct {
+	db *gorm.DB
+}
+
+func NewStatusHistoryRepository(db *gorm.DB) domain.StatusHistoryRepository {
+	return &statusHistoryRepository{db: db}
+}
+
+func (r *statusHistoryRepository) SaveStatusChange(ctx context.Context, change *domain.StatusChange) error {
+	model := toStatusHistoryModel(change)
+
+	if err := transaction.FromContext(ctx, r.db).Create(&model).Error; err != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to save status change", err)
+	}
+
+	change.ID = model.ID
+	change.ChangedAt = model.ChangedAt
+
+	return nil
+}
+
+func (r *statusHistoryRepository) FindByProductID(ctx context.Context, productID uint) ([]*domain.StatusChange, error) {
+	var models []StatusHistoryModel
+
+	err := transaction.FromContext(ctx, r.db).
+		Where("product_id = ?", productID).
+		Order("changed_at, id").
+		Find(&models).Error
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to find status history by product id", err)
+	}
+
+	changes := make([]*domain.StatusChange, 0, len(models))
+	for _, m := range models {
+		changes = append(changes, toDomainStatusChange(m))
+	}
+
+	return changes, nil
+}
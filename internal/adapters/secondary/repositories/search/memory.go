@@ -0,0 +1,166 @@
+package search
+
+import (
+	"cmp"
+	"context"
+	"html"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/product"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/search"
+)
+
+// field weights follow the defaults of postgres ts_rank for the A, B and C weights
+var fieldWeights = map[string]float64{
+	search.FieldName:   1.0,
+	search.FieldReason: 0.4,
+	search.FieldLink:   0.2,
+}
+
+// MemorySearchRepository keeps indexed products in memory and matches them the same way
+// the postgres search does, every term has to start a word of the name, the link or a
+// reason. Ranks are comparable between results but not with the postgres ones.
+type MemorySearchRepository struct {
+	mu       sync.RWMutex
+	products map[uint]*product.Product
+}
+
+func NewMemorySearchRepository() *MemorySearchRepository {
+	return &MemorySearchRepository{products: make(map[uint]*product.Product)}
+}
+
+// Index adds the product with its causes, or replaces a product with the same id.
+func (r *MemorySearchRepository) Index(p *product.Product) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.products[p.ID] = p
+}
+
+func (r *MemorySearchRepository) Remove(productID uint) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.products, productID)
+}
+
+func (r *MemorySearchRepository) SearchProducts(ctx context.Context, ownerID uint, query *search.SearchQuery) ([]*search.SearchResult, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	results := []*search.SearchResult{}
+	for _, p := range r.products {
+		if p.OwnerID != ownerID {
+			continue
+		}
+
+		if result := match(p, query.Terms); result != nil {
+			results = append(results, result)
+		}
+	}
+
+	slices.SortFunc(results, func(a, b *search.SearchResult) int {
+		if c := cmp.Compare(b.Rank, a.Rank); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Product.ID, b.Product.ID)
+	})
+
+	if len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+
+	return results, nil
+}
+
+func match(p *product.Product, terms []string) *search.SearchResult {
+	var reasons []string
+	for _, c := range p.Causes {
+		reasons = append(reasons, c.Reason)
+	}
+
+	fields := []struct {
+		name  string
+		texts []string
+	}{
+		{search.FieldName, []string{p.Name}},
+		{search.FieldReason, reasons},
+		{search.FieldLink, []string{p.Link}},
+	}
+
+	matched := make(map[string]bool, len(terms))
+	result := &search.SearchResult{Product: p, Highlights: []*search.SearchHighlight{}}
+
+	for _, field := range fields {
+		var snippets []string
+		for _, text := range field.texts {
+			snippet, hits := highlight(text, terms, matched)
+			if hits == 0 {
+				continue
+			}
+
+			result.Rank += fieldWeights[field.name] * float64(hits)
+			snippets = append(snippets, snippet)
+		}
+
+		if len(snippets) > 0 {
+			result.Highlights = append(result.Highlights, &search.SearchHighlight{
+				Field:   field.name,
+				Snippet: strings.Join(snippets, " ... "),
+			})
+		}
+	}
+
+	if len(matched) < len(terms) {
+		return nil
+	}
+
+	return result
+}
+
+// highlight marks the words of text starting with any of the terms, records the terms
+// that matched and returns how many words were marked. Like the postgres snippets the
+// text is HTML escaped, words only hold letters and digits so the rest is escaped
+func highlight(text string, terms []string, matched map[string]bool) (string, int) {
+	var b strings.Builder
+	hits := 0
+
+	isWord := func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }
+
+	runes := []rune(text)
+	for i := 0; i < len(runes); {
+		if !isWord(runes[i]) {
+			b.WriteString(html.EscapeString(string(runes[i])))
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(runes) && isWord(runes[end]) {
+			end++
+		}
+		word := string(runes[i:end])
+
+		hit := false
+		lower := strings.ToLower(word)
+		for _, term := range terms {
+			if strings.HasPrefix(lower, term) {
+				matched[term] = true
+				hit = true
+			}
+		}
+
+		if hit {
+			hits++
+			b.WriteString(search.HighlightStart + word + search.HighlightStop)
+		} else {
+			b.WriteString(word)
+		}
+		i = end
+	}
+
+	return b.String(), hits
+}
@@ -0,0 +1,54 @@
+package settings
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/transaction"
+	domain "github.com/zhunismp/intent-products-api/internal/core/domain/settings"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type settingsRepository struct {
+	db *gorm.DB
+}
+
+func NewSettingsRepository(db *gorm.DB) domain.SettingsRepository {
+	return &settingsRepository{db: db}
+}
+
+func (r *settingsRepository) FindByOwner(ctx context.Context, ownerID uint) (*domain.Settings, error) {
+	var model SettingsModel
+	err := transaction.FromContext(ctx, r.db).
+		Where("owner_id = ?", ownerID).
+		First(&model).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get settings", err)
+	}
+
+	return toDomainSettings(model), nil
+}
+
+func (r *settingsRepository) SaveSettings(ctx context.Context, settings *domain.Settings) (*domain.Settings, error) {
+	model := toSettingsModel(settings)
+
+	// two first saves of the same owner race on the key, the later one wins
+	err := transaction.FromContext(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "owner_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"cooling_off_hours", "updated_at"}),
+		}).
+		Create(&model).Error
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to save settings", err)
+	}
+
+	return toDomainSettings(model), nil
+}
@@ -0,0 +1,34 @@
+package settings
+
+import (
+	"time"
+
+	domain "github.com/zhunismp/intent-products-api/internal/core/domain/settings"
+)
+
+// SettingsModel holds one row per owner who changed a default, the owner is the key.
+type SettingsModel struct {
+	OwnerID         uint `gorm:"primaryKey;autoIncrement:false"`
+	CoolingOffHours int  `gorm:"not null"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (SettingsModel) TableName() string {
+	return "owner_settings"
+}
+
+func toSettingsModel(d *domain.Settings) SettingsModel {
+	return SettingsModel{
+		OwnerID:         d.OwnerID,
+		CoolingOffHours: d.CoolingOffHours,
+	}
+}
+
+func toDomainSettings(m SettingsModel) *domain.Settings {
+	return &domain.Settings{
+		OwnerID:         m.OwnerID,
+		CoolingOffHours: m.CoolingOffHours,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
@@ -0,0 +1,201 @@
+package sharing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/transaction"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	domain "github.com/zhunismp/intent-products-api/internal/core/domain/sharing"
+	"gorm.io/gorm"
+)
+
+type sharingRepository struct {
+	db *gorm.DB
+}
+
+func NewSharingRepository(db *gorm.DB) domain.SharingRepository {
+	return &sharingRepository{db: db}
+}
+
+func (r *sharingRepository) CreateShare(ctx context.Context, share *domain.Share) (*domain.Share, error) {
+	model := toShareModel(share)
+
+	if err := transaction.FromContext(ctx, r.db).Create(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, apperrors.New(
+				apperrors.ErrCodeConflict,
+				fmt.Sprintf("wishlist id %d is already shared with user id %d", share.ListID, share.UserID),
+				err,
+			)
+		}
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to create share", err)
+	}
+
+	return toDomainShare(model), nil
+}
+
+func (r *sharingRepository) GetShare(ctx context.Context, shareID uint) (*domain.Share, error) {
+	var model ShareModel
+	err := transaction.FromContext(ctx, r.db).
+		Where("id = ?", shareID).
+		First(&model).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("invitation id %d not found", shareID),
+			err,
+		)
+	}
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get share", err)
+	}
+
+	return toDomainShare(model), nil
+}
+
+func (r *sharingRepository) FindByList(ctx context.Context, listID uint) ([]*domain.Share, error) {
+	var models []ShareModel
+	err := transaction.FromContext(ctx, r.db).
+		Where("list_id = ? AND status IN ?", listID, []string{domain.SharePending, domain.ShareAccepted}).
+		Order("id").
+		Find(&models).Error
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get wishlist shares", err)
+	}
+
+	return toDomainShares(models), nil
+}
+
+func (r *sharingRepository) FindByUser(ctx context.Context, userID uint, statuses []string) ([]*domain.Share, error) {
+	var models []ShareModel
+	err := transaction.FromContext(ctx, r.db).
+		Where("user_id = ? AND status IN ?", userID, statuses).
+		Order("id DESC").
+		Find(&models).Error
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get invitations", err)
+	}
+
+	return toDomainShares(models), nil
+}
+
+func (r *sharingRepository) FindRole(ctx context.Context, listID, userID uint) (string, error) {
+	var roles []string
+	err := transaction.FromContext(ctx, r.db).
+		Model(&ShareModel{}).
+		Where("list_id = ? AND user_id = ? AND status = ?", listID, userID, domain.ShareAccepted).
+		Limit(1).
+		Pluck("role", &roles).Error
+
+	if err != nil {
+		return "", apperrors.New(apperrors.ErrCodeInternal, "failed to get wishlist role", err)
+	}
+	if len(roles) == 0 {
+		return "", nil
+	}
+
+	return roles[0], nil
+}
+
+func (r *sharingRepository) AcceptShare(ctx context.Context, shareID uint, acceptedAt time.Time) error {
+	return r.setStatus(ctx, shareID, domain.SharePending, map[string]any{
+		"status":      domain.ShareAccepted,
+		"accepted_at": acceptedAt,
+	})
+}
+
+func (r *sharingRepository) RevokeShare(ctx context.Context, shareID uint, revokedAt time.Time) error {
+	return r.setStatus(ctx, shareID, "", map[string]any{
+		"status":     domain.ShareRevoked,
+		"revoked_at": revokedAt,
+	})
+}
+
+// setStatus applies updates to an active share, one in status from when it is not empty.
+func (r *sharingRepository) setStatus(ctx context.Context, shareID uint, from string, updates map[string]any) error {
+	q := transaction.FromContext(ctx, r.db).
+		Model(&ShareModel{}).
+		Where("id = ?", shareID)
+	if from != "" {
+		q = q.Where("status = ?", from)
+	} else {
+		q = q.Where("status IN ?", []string{domain.SharePending, domain.ShareAccepted})
+	}
+
+	result := q.Updates(updates)
+	if result.Error != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to update share", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.New(
+			apperrors.ErrCodeConflict,
+			fmt.Sprintf("invitation id %d changed concurrently", shareID),
+			nil,
+		)
+	}
+
+	return nil
+}
+
+func (r *sharingRepository) GetListOwner(ctx context.Context, listID uint) (uint, error) {
+	var owners []uint
+	err := transaction.FromContext(ctx, r.db).
+		Table("wishlists").
+		Where("id = ? AND deleted_at IS NULL", listID).
+		Limit(1).
+		Pluck("owner_id", &owners).Error
+
+	if err != nil {
+		return 0, apperrors.New(apperrors.ErrCodeInternal, "failed to get wishlist owner", err)
+	}
+	if len(owners) == 0 {
+		return 0, apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("wishlist id %d not found", listID),
+			nil,
+		)
+	}
+
+	return owners[0], nil
+}
+
+func (r *sharingRepository) GetProductPlacement(ctx context.Context, productID uint) (uint, uint, error) {
+	var rows []struct {
+		OwnerID uint
+		ListID  uint
+	}
+	err := transaction.FromContext(ctx, r.db).
+		Table("products").
+		Select("owner_id, list_id").
+		Where("id = ? AND deleted_at IS NULL", productID).
+		Limit(1).
+		Scan(&rows).Error
+
+	if err != nil {
+		return 0, 0, apperrors.New(apperrors.ErrCodeInternal, "failed to get product placement", err)
+	}
+	if len(rows) == 0 {
+		return 0, 0, apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("product id %d not found", productID),
+			nil,
+		)
+	}
+
+	return rows[0].OwnerID, rows[0].ListID, nil
+}
+
+func toDomainShares(models []ShareModel) []*domain.Share {
+	shares := make([]*domain.Share, 0, len(models))
+	for _, m := range models {
+		shares = append(shares, toDomainShare(m))
+	}
+	return shares
+}
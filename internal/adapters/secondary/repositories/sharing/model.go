@@ -0,0 +1,54 @@
+package sharing
+
+import (
+	"time"
+
+	domain "github.com/zhunismp/intent-products-api/internal/core/domain/sharing"
+	"gorm.io/gorm"
+)
+
+type ShareModel struct {
+	gorm.Model
+	ListID     uint   `gorm:"type:bigint;not null"`
+	OwnerID    uint   `gorm:"type:bigint;not null"`
+	UserID     uint   `gorm:"type:bigint;not null"`
+	Role       string `gorm:"type:varchar(16);not null"`
+	Status     string `gorm:"type:varchar(16);not null"`
+	InvitedBy  uint   `gorm:"type:bigint;not null"`
+	AcceptedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+func (ShareModel) TableName() string {
+	return "wishlist_shares"
+}
+
+func toShareModel(d *domain.Share) ShareModel {
+	return ShareModel{
+		Model:      gorm.Model{ID: d.ID},
+		ListID:     d.ListID,
+		OwnerID:    d.OwnerID,
+		UserID:     d.UserID,
+		Role:       d.Role,
+		Status:     d.Status,
+		InvitedBy:  d.InvitedBy,
+		AcceptedAt: d.AcceptedAt,
+		RevokedAt:  d.RevokedAt,
+	}
+}
+
+func toDomainShare(m ShareModel) *domain.Share {
+	return &domain.Share{
+		ID:         m.ID,
+		ListID:     m.ListID,
+		OwnerID:    m.OwnerID,
+		UserID:     m.UserID,
+		Role:       m.Role,
+		Status:     m.Status,
+		InvitedBy:  m.InvitedBy,
+		AcceptedAt: m.AcceptedAt,
+		RevokedAt:  m.RevokedAt,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
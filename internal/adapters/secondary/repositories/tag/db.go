@@ -0,0 +1,220 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/transaction"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	domain "github.com/zhunismp/intent-products-api/internal/core/domain/tag"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type tagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) domain.TagRepository {
+	return &tagRepository{db: db}
+}
+
+func (r *tagRepository) CreateTag(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
+	model := toTagModel(tag)
+
+	if err := transaction.FromContext(ctx, r.db).Create(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, nameTakenError(tag.Name, err)
+		}
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to create tag", err)
+	}
+
+	return toDomainTag(model), nil
+}
+
+func (r *tagRepository) GetTag(ctx context.Context, ownerID, tagID uint) (*domain.Tag, error) {
+	var model TagModel
+	err := transaction.FromContext(ctx, r.db).
+		Where("id = ? AND owner_id = ?", tagID, ownerID).
+		First(&model).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("tag id %d not found for owner id %d", tagID, ownerID),
+			err,
+		)
+	}
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get tag", err)
+	}
+
+	return toDomainTag(model), nil
+}
+
+func (r *tagRepository) FindByOwner(ctx context.Context, ownerID uint) ([]*domain.Tag, error) {
+	var models []TagModel
+	err := transaction.FromContext(ctx, r.db).
+		Where("owner_id = ?", ownerID).
+		Order("lower(name), id").
+		Find(&models).Error
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get tags", err)
+	}
+
+	return toDomainTags(models), nil
+}
+
+func (r *tagRepository) CountOwned(ctx context.Context, ownerID uint, tagIDs []uint) (int64, error) {
+	var count int64
+	err := transaction.FromContext(ctx, r.db).
+		Model(&TagModel{}).
+		Where("owner_id = ? AND id IN ?", ownerID, tagIDs).
+		Count(&count).Error
+
+	if err != nil {
+		return 0, apperrors.New(apperrors.ErrCodeInternal, "failed to count tags", err)
+	}
+
+	return count, nil
+}
+
+func (r *tagRepository) UpdateTag(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
+	result := transaction.FromContext(ctx, r.db).
+		Model(&TagModel{}).
+		Where("id = ? AND owner_id = ?", tag.ID, tag.OwnerID).
+		Update("name", tag.Name)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return nil, nameTakenError(tag.Name, result.Error)
+		}
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to update tag", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("tag id %d not found for owner id %d", tag.ID, tag.OwnerID),
+			nil,
+		)
+	}
+
+	return r.GetTag(ctx, tag.OwnerID, tag.ID)
+}
+
+func (r *tagRepository) DeleteTag(ctx context.Context, ownerID, tagID uint) error {
+	result := transaction.FromContext(ctx, r.db).
+		Where("id = ? AND owner_id = ?", tagID, ownerID).
+		Delete(&TagModel{})
+
+	if result.Error != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to delete tag", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("tag id %d not found for owner id %d", tagID, ownerID),
+			nil,
+		)
+	}
+
+	return nil
+}
+
+func (r *tagRepository) FindByProductID(ctx context.Context, productID uint) ([]*domain.Tag, error) {
+	var models []TagModel
+	err := transaction.FromContext(ctx, r.db).
+		Joins("JOIN product_tags ON product_tags.tag_id = tags.id").
+		Where("product_tags.product_id = ?", productID).
+		Order("lower(tags.name), tags.id").
+		Find(&models).Error
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to find tags by product id", err)
+	}
+
+	return toDomainTags(models), nil
+}
+
+func (r *tagRepository) LinkProduct(ctx context.Context, productID uint, tagIDs []uint) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	links := make([]ProductTagModel, len(tagIDs))
+	for i, id := range tagIDs {
+		links[i] = ProductTagModel{ProductID: productID, TagID: id}
+	}
+
+	err := transaction.FromContext(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&links).Error
+	if err != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to tag product", err)
+	}
+
+	return nil
+}
+
+func (r *tagRepository) UnlinkProduct(ctx context.Context, productID, tagID uint) error {
+	result := transaction.FromContext(ctx, r.db).
+		Where("product_id = ? AND tag_id = ?", productID, tagID).
+		Delete(&ProductTagModel{})
+
+	if result.Error != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to untag product", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("product id %d is not tagged with tag id %d", productID, tagID),
+			nil,
+		)
+	}
+
+	return nil
+}
+
+func (r *tagRepository) UnlinkAllOfProduct(ctx context.Context, productID uint) error {
+	err := transaction.FromContext(ctx, r.db).
+		Where("product_id = ?", productID).
+		Delete(&ProductTagModel{}).Error
+
+	if err != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to untag product", err)
+	}
+
+	// no rows affected is fine, a product may not have any tags
+	return nil
+}
+
+func (r *tagRepository) UnlinkAllOfTag(ctx context.Context, tagID uint) error {
+	err := transaction.FromContext(ctx, r.db).
+		Where("tag_id = ?", tagID).
+		Delete(&ProductTagModel{}).Error
+
+	if err != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to untag products", err)
+	}
+
+	return nil
+}
+
+func toDomainTags(models []TagModel) []*domain.Tag {
+	tags := make([]*domain.Tag, 0, len(models))
+	for _, m := range models {
+		tags = append(tags, toDomainTag(m))
+	}
+	return tags
+}
+
+func nameTakenError(name string, err error) error {
+	return apperrors.New(
+		apperrors.ErrCodeConflict,
+		fmt.Sprintf("a tag named %q already exists", name),
+		err,
+	)
+}
@@ -0,0 +1,47 @@
+package tag
+
+import (
+	"time"
+
+	domain "github.com/zhunismp/intent-products-api/internal/core/domain/tag"
+	"gorm.io/gorm"
+)
+
+type TagModel struct {
+	gorm.Model
+	OwnerID uint   `gorm:"type:bigint;not null"`
+	Name    string `gorm:"type:varchar(50);not null"`
+}
+
+func (TagModel) TableName() string {
+	return "tags"
+}
+
+// ProductTagModel links a product to a tag, the pair is the key.
+type ProductTagModel struct {
+	ProductID uint `gorm:"primaryKey"`
+	TagID     uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+}
+
+func (ProductTagModel) TableName() string {
+	return "product_tags"
+}
+
+func toTagModel(d *domain.Tag) TagModel {
+	return TagModel{
+		Model:   gorm.Model{ID: d.ID},
+		OwnerID: d.OwnerID,
+		Name:    d.Name,
+	}
+}
+
+func toDomainTag(m TagModel) *domain.Tag {
+	return &domain.Tag{
+		ID:        m.ID,
+		OwnerID:   m.OwnerID,
+		Name:      m.Name,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
@@ -0,0 +1,170 @@
+package wishlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zhunismp/intent-products-api/internal/adapters/secondary/infrastructure/transaction"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	domain "github.com/zhunismp/intent-products-api/internal/core/domain/wishlist"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type wishlistRepository struct {
+	db *gorm.DB
+}
+
+func NewWishlistRepository(db *gorm.DB) domain.WishlistRepository {
+	return &wishlistRepository{db: db}
+}
+
+func (r *wishlistRepository) CreateWishlist(ctx context.Context, list *domain.Wishlist) (*domain.Wishlist, error) {
+	model := toWishlistModel(list)
+
+	if err := transaction.FromContext(ctx, r.db).Create(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, nameTakenError(list.Name, err)
+		}
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to create wishlist", err)
+	}
+
+	return toDomainWishlist(model), nil
+}
+
+func (r *wishlistRepository) GetWishlist(ctx context.Context, ownerID uint, listID uint) (*domain.Wishlist, error) {
+	var model WishlistModel
+	err := transaction.FromContext(ctx, r.db).
+		Where("id = ? AND owner_id = ?", listID, ownerID).
+		First(&model).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("wishlist id %d not found for owner id %d", listID, ownerID),
+			err,
+		)
+	}
+
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get wishlist", err)
+	}
+
+	return toDomainWishlist(model), nil
+}
+
+func (r *wishlistRepository) EnsureDefault(ctx context.Context, ownerID uint) (*domain.Wishlist, error) {
+	db := transaction.FromContext(ctx, r.db)
+
+	var models []WishlistModel
+	if err := db.Where("owner_id = ? AND is_default", ownerID).Limit(1).Find(&models).Error; err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get default wishlist", err)
+	}
+	if len(models) > 0 {
+		return toDomainWishlist(models[0]), nil
+	}
+
+	// a concurrent request may create it first, the partial unique index keeps one default
+	// per owner and the insert quietly yields to it
+	model := WishlistModel{OwnerID: ownerID, Name: domain.DefaultWishlistName, IsDefault: true}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model).Error; err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to create default wishlist", err)
+	}
+
+	var existing WishlistModel
+	if err := db.Where("owner_id = ? AND is_default", ownerID).First(&existing).Error; err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get default wishlist", err)
+	}
+
+	return toDomainWishlist(existing), nil
+}
+
+func (r *wishlistRepository) FindByOwner(ctx context.Context, ownerID uint, includeArchived bool) ([]*domain.Wishlist, error) {
+	q := transaction.FromContext(ctx, r.db).
+		Where("owner_id = ?", ownerID)
+	if !includeArchived {
+		q = q.Where("NOT archived")
+	}
+
+	var models []WishlistModel
+	if err := q.Order("is_default DESC, id").Find(&models).Error; err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to get wishlists", err)
+	}
+
+	lists := make([]*domain.Wishlist, 0, len(models))
+	for _, m := range models {
+		lists = append(lists, toDomainWishlist(m))
+	}
+
+	return lists, nil
+}
+
+func (r *wishlistRepository) UpdateWishlist(ctx context.Context, list *domain.Wishlist) (*domain.Wishlist, error) {
+	result := transaction.FromContext(ctx, r.db).
+		Model(&WishlistModel{}).
+		Where("id = ? AND owner_id = ?", list.ID, list.OwnerID).
+		Updates(map[string]any{
+			"name":     list.Name,
+			"emoji":    list.Emoji,
+			"color":    list.Color,
+			"archived": list.Archived,
+		})
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return nil, nameTakenError(list.Name, result.Error)
+		}
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to update wishlist", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("wishlist id %d not found for owner id %d", list.ID, list.OwnerID),
+			nil,
+		)
+	}
+
+	return r.GetWishlist(ctx, list.OwnerID, list.ID)
+}
+
+func (r *wishlistRepository) CountProducts(ctx context.Context, listID uint) (int64, error) {
+	var count int64
+	err := transaction.FromContext(ctx, r.db).
+		Table("products").
+		Where("list_id = ? AND deleted_at IS NULL", listID).
+		Count(&count).Error
+
+	if err != nil {
+		return 0, apperrors.New(apperrors.ErrCodeInternal, "failed to count wishlist products", err)
+	}
+
+	return count, nil
+}
+
+func (r *wishlistRepository) DeleteWishlist(ctx context.Context, ownerID uint, listID uint) error {
+	result := transaction.FromContext(ctx, r.db).
+		Where("id = ? AND owner_id = ?", listID, ownerID).
+		Delete(&WishlistModel{})
+
+	if result.Error != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to delete wishlist", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("wishlist id %d not found for owner id %d", listID, ownerID),
+			nil,
+		)
+	}
+
+	return nil
+}
+
+func nameTakenError(name string, err error) error {
+	return apperrors.New(
+		apperrors.ErrCodeConflict,
+		fmt.Sprintf("a wishlist named %q already exists", name),
+		err,
+	)
+}
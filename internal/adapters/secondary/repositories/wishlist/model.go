@@ -0,0 +1,46 @@
+package wishlist
+
+import (
+	domain "github.com/zhunismp/intent-products-api/internal/core/domain/wishlist"
+	"gorm.io/gorm"
+)
+
+type WishlistModel struct {
+	gorm.Model
+	OwnerID   uint   `gorm:"type:bigint;not null"`
+	Name      string `gorm:"type:varchar(100);not null"`
+	Emoji     string `gorm:"type:varchar(16);not null"`
+	Color     string `gorm:"type:varchar(7);not null"`
+	Archived  bool   `gorm:"not null"`
+	IsDefault bool   `gorm:"not null"`
+}
+
+func (WishlistModel) TableName() string {
+	return "wishlists"
+}
+
+func toWishlistModel(d *domain.Wishlist) WishlistModel {
+	return WishlistModel{
+		Model:     gorm.Model{ID: d.ID},
+		OwnerID:   d.OwnerID,
+		Name:      d.Name,
+		Emoji:     d.Emoji,
+		Color:     d.Color,
+		Archived:  d.Archived,
+		IsDefault: d.IsDefault,
+	}
+}
+
+func toDomainWishlist(m WishlistModel) *domain.Wishlist {
+	return &domain.Wishlist{
+		ID:        m.ID,
+		OwnerID:   m.OwnerID,
+		Name:      m.Name,
+		Emoji:     m.Emoji,
+		Color:     m.Color,
+		Archived:  m.Archived,
+		IsDefault: m.IsDefault,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
@@ -0,0 +1,83 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/auth"
+)
+
+// keys look like ipk_<prefix>_<secret>, the prefix is stored in clear to look the key up
+const (
+	keyTag       = "ipk"
+	prefixBytes  = 6
+	secretBytes  = 24
+	keySeparator = "_"
+)
+
+// TODO: when logic is complex, should not return domain object directly
+type ApiKey struct {
+	ID         uint       `json:"id"`
+	OwnerID    uint       `json:"ownerId"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Hash       string     `json:"-"`
+	Scopes     []string   `json:"scopes"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// IsRevoked reports whether the key can no longer be used.
+func (k *ApiKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// IsValidScope reports whether scope is one a key can be granted.
+func IsValidScope(scope string) bool {
+	return scope == auth.ScopeRead || scope == auth.ScopeWrite
+}
+
+// generateKey returns a new raw key together with its lookup prefix and hash.
+// The raw key is only ever shown to the owner once.
+func generateKey() (raw string, prefix string, hash string, err error) {
+	prefixBuf := make([]byte, prefixBytes)
+	secretBuf := make([]byte, secretBytes)
+	if _, err := rand.Read(prefixBuf); err != nil {
+		return "", "", "", fmt.Errorf("generate key prefix: %w", err)
+	}
+	if _, err := rand.Read(secretBuf); err != nil {
+		return "", "", "", fmt.Errorf("generate key secret: %w", err)
+	}
+
+	prefix = hex.EncodeToString(prefixBuf)
+	raw = strings.Join([]string{keyTag, prefix, base64.RawURLEncoding.EncodeToString(secretBuf)}, keySeparator)
+
+	return raw, prefix, hashKey(raw), nil
+}
+
+// parsePrefix returns the lookup prefix of a raw key.
+func parsePrefix(raw string) (string, bool) {
+	parts := strings.SplitN(raw, keySeparator, 3)
+	if len(parts) != 3 || parts[0] != keyTag || parts[1] == "" || parts[2] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// keys carry enough entropy that a fast hash is sufficient, unlike passwords
+func hashKey(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+func matchesHash(raw string, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashKey(raw)), []byte(hash)) == 1
+}
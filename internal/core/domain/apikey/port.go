@@ -0,0 +1,24 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/auth"
+)
+
+type ApiKeyUsecase interface {
+	// CreateKey returns the stored key and the raw key, which can not be recovered later.
+	CreateKey(ctx context.Context, ownerID uint, name string, scopes []string) (*ApiKey, string, error)
+	ListKeys(ctx context.Context, ownerID uint) ([]*ApiKey, error)
+	RevokeKey(ctx context.Context, ownerID uint, keyID uint) error
+	Authenticate(ctx context.Context, rawKey string) (*auth.Principal, error)
+}
+
+type ApiKeyRepository interface {
+	CreateKey(ctx context.Context, key *ApiKey) (*ApiKey, error)
+	FindByOwnerID(ctx context.Context, ownerID uint) ([]*ApiKey, error)
+	FindByPrefix(ctx context.Context, prefix string) (*ApiKey, error)
+	RevokeKey(ctx context.Context, ownerID uint, keyID uint, revokedAt time.Time) error
+	UpdateLastUsed(ctx context.Context, keyID uint, usedAt time.Time) error
+}
@@ -0,0 +1,125 @@
+package apikey
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/auth"
+)
+
+type apiKeyService struct {
+	apiKeyRepo ApiKeyRepository
+	logger     *slog.Logger
+}
+
+func NewApiKeyService(apiKeyRepo ApiKeyRepository, logger *slog.Logger) ApiKeyUsecase {
+	return &apiKeyService{apiKeyRepo: apiKeyRepo, logger: logger}
+}
+
+func (s *apiKeyService) CreateKey(ctx context.Context, ownerID uint, name string, scopes []string) (*ApiKey, string, error) {
+	if len(scopes) == 0 {
+		return nil, "", apperrors.New(apperrors.ErrCodeValidation, "at least one scope is required", nil)
+	}
+	for _, scope := range scopes {
+		if !IsValidScope(scope) {
+			return nil, "", apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("scope %q is not supported", scope), nil)
+		}
+	}
+
+	raw, prefix, hash, err := generateKey()
+	if err != nil {
+		return nil, "", apperrors.New(apperrors.ErrCodeInternal, "failed to generate api key", err)
+	}
+
+	scopes = slices.Compact(slices.Sorted(slices.Values(scopes)))
+
+	key, err := s.apiKeyRepo.CreateKey(ctx, &ApiKey{
+		OwnerID: ownerID,
+		Name:    name,
+		Prefix:  prefix,
+		Hash:    hash,
+		Scopes:  scopes,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.logger.InfoContext(ctx, "created api key successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Group("api_key_info",
+			slog.Uint64("key_id", uint64(key.ID)),
+			slog.String("prefix", key.Prefix),
+			slog.Any("scopes", key.Scopes),
+		),
+	)
+
+	return key, raw, nil
+}
+
+func (s *apiKeyService) ListKeys(ctx context.Context, ownerID uint) ([]*ApiKey, error) {
+	keys, err := s.apiKeyRepo.FindByOwnerID(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "fetched api keys successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Int("key_count", len(keys)),
+	)
+
+	return keys, nil
+}
+
+func (s *apiKeyService) RevokeKey(ctx context.Context, ownerID uint, keyID uint) error {
+	if err := s.apiKeyRepo.RevokeKey(ctx, ownerID, keyID, time.Now()); err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "revoked api key successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Uint64("key_id", uint64(keyID)),
+	)
+
+	return nil
+}
+
+func (s *apiKeyService) Authenticate(ctx context.Context, rawKey string) (*auth.Principal, error) {
+	invalid := apperrors.New(apperrors.ErrCodeUnauthorized, "api key is invalid", nil)
+
+	prefix, ok := parsePrefix(rawKey)
+	if !ok {
+		return nil, invalid
+	}
+
+	key, err := s.apiKeyRepo.FindByPrefix(ctx, prefix)
+	if err != nil {
+		if apperrors.IsCode(err, apperrors.ErrCodeNotFound) {
+			return nil, invalid
+		}
+		return nil, err
+	}
+
+	if !matchesHash(rawKey, key.Hash) || key.IsRevoked() {
+		return nil, invalid
+	}
+
+	// a failed bookkeeping write should not reject a valid key
+	if err := s.apiKeyRepo.UpdateLastUsed(ctx, key.ID, time.Now()); err != nil {
+		s.logger.WarnContext(ctx, "failed to record api key usage",
+			slog.Uint64("key_id", uint64(key.ID)),
+			slog.Any("error", err),
+		)
+	}
+
+	return &auth.Principal{
+		UserID:  key.OwnerID,
+		Subject: strconv.FormatUint(uint64(key.OwnerID), 10),
+		Method:  auth.MethodAPIKey,
+		Scopes:  key.Scopes,
+	}, nil
+}
@@ -1,13 +1,146 @@
 package cause
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+const (
+	PolarityPro string = "pro"
+	PolarityCon string = "con"
+)
+
+const (
+	MinCauseWeight     int = 1
+	MaxCauseWeight     int = 5
+	DefaultCauseWeight int = 3
+)
 
 // TODO: when logic is complex, should not return domain object directly
 type Cause struct {
-	ID     uint   `json:"id"`
-	Reason string `json:"reason"`
-	Status bool   `json:"status"`
+	ID       uint   `json:"id"`
+	Reason   string `json:"reason"`
+	Status   bool   `json:"status"`   // false once the reason no longer applies
+	Polarity string `json:"polarity"` // pro argues for buying, con against
+	Weight   int    `json:"weight"`
+	Position string `json:"-"`
 
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updateAt"`
 }
+
+func IsValidPolarity(polarity string) bool {
+	return polarity == PolarityPro || polarity == PolarityCon
+}
+
+func IsValidCauseWeight(weight int) bool {
+	return weight >= MinCauseWeight && weight <= MaxCauseWeight
+}
+
+// ResolveWeighting fills in the defaults for a polarity and weight left empty, a pro of
+// average weight, and rejects anything else out of range.
+func ResolveWeighting(polarity string, weight int) (string, int, error) {
+	if polarity == "" {
+		polarity = PolarityPro
+	}
+	if weight == 0 {
+		weight = DefaultCauseWeight
+	}
+
+	if !IsValidPolarity(polarity) {
+		return "", 0, apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("polarity %q is invalid", polarity), nil)
+	}
+	if !IsValidCauseWeight(weight) {
+		return "", 0, apperrors.New(
+			apperrors.ErrCodeValidation,
+			fmt.Sprintf("weight must be between %d and %d", MinCauseWeight, MaxCauseWeight),
+			nil,
+		)
+	}
+
+	return polarity, weight, nil
+}
+
+// CausePatch describes a partial update of a cause. Nil fields are left untouched.
+type CausePatch struct {
+	Reason   *string
+	Status   *bool
+	Polarity *string
+	Weight   *int
+}
+
+func (p *CausePatch) IsEmpty() bool {
+	return p == nil || p.Reason == nil && p.Status == nil && p.Polarity == nil && p.Weight == nil
+}
+
+// ChangesScore reports whether applying the patch can move the product's confidence.
+func (p *CausePatch) ChangesScore() bool {
+	return p != nil && (p.Status != nil || p.Polarity != nil || p.Weight != nil)
+}
+
+func (p *CausePatch) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.Reason != nil && strings.TrimSpace(*p.Reason) == "" {
+		return apperrors.New(apperrors.ErrCodeValidation, "reason can not be empty", nil)
+	}
+	if p.Polarity != nil && !IsValidPolarity(*p.Polarity) {
+		return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("polarity %q is invalid", *p.Polarity), nil)
+	}
+	if p.Weight != nil && !IsValidCauseWeight(*p.Weight) {
+		return apperrors.New(
+			apperrors.ErrCodeValidation,
+			fmt.Sprintf("weight must be between %d and %d", MinCauseWeight, MaxCauseWeight),
+			nil,
+		)
+	}
+	return nil
+}
+
+func (p *CausePatch) ApplyTo(c *Cause) {
+	if p.Reason != nil {
+		c.Reason = *p.Reason
+	}
+	if p.Status != nil {
+		c.Status = *p.Status
+	}
+	if p.Polarity != nil {
+		c.Polarity = *p.Polarity
+	}
+	if p.Weight != nil {
+		c.Weight = *p.Weight
+	}
+}
+
+// ValidateCauseOrder checks ids lists every cause of current exactly once.
+func ValidateCauseOrder(current []*Cause, ids []uint) error {
+	if len(ids) != len(current) {
+		return apperrors.New(
+			apperrors.ErrCodeValidation,
+			fmt.Sprintf("order lists %d causes but the product has %d", len(ids), len(current)),
+			nil,
+		)
+	}
+
+	known := make(map[uint]bool, len(current))
+	for _, c := range current {
+		known[c.ID] = true
+	}
+
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		if !known[id] {
+			return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("cause id %d does not belong to the product", id), nil)
+		}
+		if seen[id] {
+			return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("cause id %d is listed more than once", id), nil)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
@@ -3,13 +3,31 @@ package cause
 import "context"
 
 type CauseUsecase interface {
-	BulkCreateCauses(ctx context.Context, productID uint, reasons []string) error
+	// BulkCreateCauses adds active causes sharing one polarity and weight, an empty polarity
+	// and a zero weight fall back to the defaults.
+	BulkCreateCauses(ctx context.Context, productID uint, reasons []string, polarity string, weight int) error
+	// RestoreCauses saves causes as they are, keeping whether they are active and how they weigh.
+	RestoreCauses(ctx context.Context, productID uint, causes []*Cause) error
+	// GetCauses returns the causes of the product in their order.
 	GetCauses(ctx context.Context, productID uint) ([]*Cause, error)
 	DeleteCauses(ctx context.Context, productID uint) error
+
+	UpdateCause(ctx context.Context, productID uint, causeID uint, patch *CausePatch) (*Cause, error)
+	DeleteCause(ctx context.Context, productID uint, causeID uint) error
+	// ReorderCauses applies a complete order of the product's causes and returns them in it.
+	ReorderCauses(ctx context.Context, productID uint, causeIDs []uint) ([]*Cause, error)
 }
 
 type CauseRepository interface {
 	BulkSaveCauses(ctx context.Context, productID uint, causes []*Cause) error
 	FindByProductID(ctx context.Context, productID uint) ([]*Cause, error)
 	DeleteByProductID(ctx context.Context, productID uint) error
+
+	GetCause(ctx context.Context, productID uint, causeID uint) (*Cause, error)
+	UpdateCause(ctx context.Context, productID uint, cause *Cause) (*Cause, error)
+	DeleteCause(ctx context.Context, productID uint, causeID uint) error
+	// GetLastPosition returns the position of the product's last cause, "" when it has none.
+	GetLastPosition(ctx context.Context, productID uint) (string, error)
+	// ReplacePositions rewrites several positions of the product's causes at once.
+	ReplacePositions(ctx context.Context, productID uint, positions map[uint]string) error
 }
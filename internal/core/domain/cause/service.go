@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/utils/ordering"
 )
 
 type causeService struct {
@@ -15,15 +18,26 @@ func NewCauseService(causeRepo CauseRepository, logger *slog.Logger) CauseUsecas
 	return &causeService{causeRepo: causeRepo, logger: logger}
 }
 
-func (s *causeService) BulkCreateCauses(ctx context.Context, productID uint, reasons []string) error {
+func (s *causeService) BulkCreateCauses(ctx context.Context, productID uint, reasons []string, polarity string, weight int) error {
+	polarity, weight, err := ResolveWeighting(polarity, weight)
+	if err != nil {
+		return err
+	}
+
 	causes := make([]*Cause, 0, len(reasons))
 	for _, reason := range reasons {
 		causes = append(causes, &Cause{
-			Reason: reason,
-			Status: true,
+			Reason:   reason,
+			Status:   true,
+			Polarity: polarity,
+			Weight:   weight,
 		})
 	}
 
+	if err := s.appendPositions(ctx, productID, causes); err != nil {
+		return err
+	}
+
 	if err := s.causeRepo.BulkSaveCauses(ctx, productID, causes); err != nil {
 		return fmt.Errorf("failed to bulk save causes for product %d: %w", productID, err)
 	}
@@ -33,12 +47,46 @@ func (s *causeService) BulkCreateCauses(ctx context.Context, productID uint, rea
 		slog.Group("cause_info",
 			slog.Any("reasons", reasons),
 			slog.Int("reason_count", len(reasons)),
+			slog.String("polarity", polarity),
+			slog.Int("weight", weight),
 		),
 	)
 
 	return nil
 }
 
+func (s *causeService) RestoreCauses(ctx context.Context, productID uint, causes []*Cause) error {
+	restored := make([]*Cause, 0, len(causes))
+	for _, c := range causes {
+		polarity, weight, err := ResolveWeighting(c.Polarity, c.Weight)
+		if err != nil {
+			return err
+		}
+
+		restored = append(restored, &Cause{
+			Reason:   c.Reason,
+			Status:   c.Status,
+			Polarity: polarity,
+			Weight:   weight,
+		})
+	}
+
+	if err := s.appendPositions(ctx, productID, restored); err != nil {
+		return err
+	}
+
+	if err := s.causeRepo.BulkSaveCauses(ctx, productID, restored); err != nil {
+		return fmt.Errorf("failed to restore causes for product %d: %w", productID, err)
+	}
+
+	s.logger.InfoContext(ctx, "restored causes successfully",
+		slog.Uint64("product_id", uint64(productID)),
+		slog.Int("cause_count", len(restored)),
+	)
+
+	return nil
+}
+
 func (s *causeService) GetCauses(ctx context.Context, productID uint) ([]*Cause, error) {
 
 	causes, err := s.causeRepo.FindByProductID(ctx, productID)
@@ -67,3 +115,117 @@ func (s *causeService) DeleteCauses(ctx context.Context, productID uint) error {
 
 	return nil
 }
+
+// appendPositions gives causes positions after the product's last cause, in order.
+func (s *causeService) appendPositions(ctx context.Context, productID uint, causes []*Cause) error {
+	if len(causes) == 0 {
+		return nil
+	}
+
+	last, err := s.causeRepo.GetLastPosition(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	keys, err := ordering.NKeysBetween(last, "", uint(len(causes)))
+	if err != nil {
+		return apperrors.New(apperrors.ErrCodeInternal, "failed to generate cause positions", err)
+	}
+	for i, c := range causes {
+		c.Position = keys[i]
+	}
+
+	return nil
+}
+
+func (s *causeService) UpdateCause(ctx context.Context, productID, causeID uint, patch *CausePatch) (*Cause, error) {
+	if err := patch.Validate(); err != nil {
+		return nil, err
+	}
+
+	c, err := s.causeRepo.GetCause(ctx, productID, causeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !patch.IsEmpty() {
+		patch.ApplyTo(c)
+
+		c, err = s.causeRepo.UpdateCause(ctx, productID, c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.logger.InfoContext(ctx, "updated cause successfully",
+		slog.Uint64("product_id", uint64(productID)),
+		slog.Group("cause_info",
+			slog.Uint64("id", uint64(c.ID)),
+			slog.String("reason", c.Reason),
+			slog.Bool("status", c.Status),
+			slog.String("polarity", c.Polarity),
+			slog.Int("weight", c.Weight),
+		),
+	)
+
+	return c, nil
+}
+
+func (s *causeService) DeleteCause(ctx context.Context, productID, causeID uint) error {
+	if err := s.causeRepo.DeleteCause(ctx, productID, causeID); err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "deleted cause successfully",
+		slog.Uint64("product_id", uint64(productID)),
+		slog.Uint64("cause_id", uint64(causeID)),
+	)
+
+	return nil
+}
+
+func (s *causeService) ReorderCauses(ctx context.Context, productID uint, causeIDs []uint) ([]*Cause, error) {
+	current, err := s.causeRepo.FindByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateCauseOrder(current, causeIDs); err != nil {
+		return nil, err
+	}
+
+	// a product has a handful of causes, spreading fresh keys over all of them keeps the
+	// keys short without tracking which ones really moved
+	keys, err := ordering.NKeysBetween("", "", uint(len(causeIDs)))
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to generate cause positions", err)
+	}
+
+	position := make(map[uint]string, len(current))
+	for _, c := range current {
+		position[c.ID] = c.Position
+	}
+
+	changed := make(map[uint]string)
+	for i, id := range causeIDs {
+		if position[id] != keys[i] {
+			changed[id] = keys[i]
+		}
+	}
+
+	if err := s.causeRepo.ReplacePositions(ctx, productID, changed); err != nil {
+		return nil, err
+	}
+
+	causes, err := s.causeRepo.FindByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "reordered causes successfully",
+		slog.Uint64("product_id", uint64(productID)),
+		slog.Int("cause_count", len(causes)),
+		slog.Int("moved_count", len(changed)),
+	)
+
+	return causes, nil
+}
@@ -0,0 +1,60 @@
+package installment
+
+import (
+	"math"
+	"time"
+)
+
+// BuildSchedule returns the amortization schedule of a plan using equal
+// monthly payments. The first payment is due on the start date, and the last
+// payment absorbs the rounding so the balance ends at exactly zero.
+func BuildSchedule(total float64, months int, interestRate float64, startDate time.Time) []*ScheduleEntry {
+	if months <= 0 {
+		return []*ScheduleEntry{}
+	}
+
+	rate := interestRate / 100 / 12
+	payment := monthlyPayment(total, months, rate)
+
+	schedule := make([]*ScheduleEntry, 0, months)
+	balance := roundCents(total)
+	for i := 0; i < months; i++ {
+		interest := roundCents(balance * rate)
+		principal := roundCents(payment - interest)
+		if i == months-1 || principal > balance {
+			principal = balance
+		}
+		balance = roundCents(balance - principal)
+
+		schedule = append(schedule, &ScheduleEntry{
+			Number:    i + 1,
+			DueDate:   startDate.AddDate(0, i, 0),
+			Payment:   roundCents(principal + interest),
+			Principal: principal,
+			Interest:  interest,
+			Balance:   balance,
+		})
+	}
+
+	return schedule
+}
+
+// MarkPaid flags the schedule entries covered by the paid amount, oldest first.
+func MarkPaid(schedule []*ScheduleEntry, paid float64) {
+	due := 0.0
+	for _, entry := range schedule {
+		due = roundCents(due + entry.Payment)
+		entry.Paid = paid >= due
+	}
+}
+
+func monthlyPayment(total float64, months int, rate float64) float64 {
+	if rate == 0 {
+		return roundCents(total / float64(months))
+	}
+	return roundCents(total * rate / (1 - math.Pow(1+rate, -float64(months))))
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,66 @@
+package installment
+
+import (
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+// TODO: when logic is complex, should not return domain object directly
+type Plan struct {
+	ID           uint       `json:"id"`
+	ProductID    uint       `json:"productId"`
+	OwnerID      uint       `json:"ownerId"`
+	Total        float64    `json:"total"`
+	Months       int        `json:"months"`
+	StartDate    time.Time  `json:"startDate"`
+	InterestRate float64    `json:"interestRate"` // annual rate in percent
+	Payments     []*Payment `json:"payments"`
+
+	// derived from the plan terms and the recorded payments
+	MonthlyPayment   float64 `json:"monthlyPayment"`
+	TotalPayable     float64 `json:"totalPayable"`
+	PaidAmount       float64 `json:"paidAmount"`
+	RemainingBalance float64 `json:"remainingBalance"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type Payment struct {
+	ID     uint      `json:"id"`
+	PlanID uint      `json:"planId"`
+	Amount float64   `json:"amount"`
+	PaidAt time.Time `json:"paidAt"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type ScheduleEntry struct {
+	Number    int       `json:"number"`
+	DueDate   time.Time `json:"dueDate"`
+	Payment   float64   `json:"payment"`
+	Principal float64   `json:"principal"`
+	Interest  float64   `json:"interest"`
+	Balance   float64   `json:"balance"`
+	Paid      bool      `json:"paid"`
+}
+
+// IsSettled reports whether the payments cover everything that is owed.
+func (p *Plan) IsSettled() bool {
+	return p.RemainingBalance <= 0
+}
+
+// validateTerms checks the plan terms. A zero total stands for the product price.
+func validateTerms(total float64, months int, interestRate float64) error {
+	if total < 0 {
+		return apperrors.New(apperrors.ErrCodeValidation, "total must not be negative", nil)
+	}
+	if months < 1 {
+		return apperrors.New(apperrors.ErrCodeValidation, "months must be at least 1", nil)
+	}
+	if interestRate < 0 {
+		return apperrors.New(apperrors.ErrCodeValidation, "interest rate must not be negative", nil)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package installment
+
+import (
+	"context"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/product"
+)
+
+type InstallmentUsecase interface {
+	CreatePlan(ctx context.Context, userID uint, productID uint, total float64, months int, startDate time.Time, interestRate float64) (*Plan, error)
+	GetPlan(ctx context.Context, userID uint, productID uint) (*Plan, error)
+	GetSchedule(ctx context.Context, userID uint, productID uint) ([]*ScheduleEntry, error)
+	// CancelPlan drops the plan with its payments and moves the product back to pending.
+	CancelPlan(ctx context.Context, userID uint, productID uint) (*product.Product, error)
+	RecordPayment(ctx context.Context, userID uint, productID uint, amount float64, paidAt time.Time) (*Plan, error)
+}
+
+type InstallmentRepository interface {
+	CreatePlan(ctx context.Context, plan *Plan) (uint, error)
+	GetPlanByProductID(ctx context.Context, ownerID uint, productID uint) (*Plan, error)
+	// LockPlanByProductID is GetPlanByProductID holding the plan row until the transaction ends.
+	LockPlanByProductID(ctx context.Context, ownerID uint, productID uint) (*Plan, error)
+	// DeletePlansByProductID removes the plans of the product with their payments.
+	DeletePlansByProductID(ctx context.Context, productID uint) error
+	SavePayment(ctx context.Context, payment *Payment) error
+	FindPaymentsByPlanID(ctx context.Context, planID uint) ([]*Payment, error)
+}
@@ -0,0 +1,331 @@
+package installment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/product"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/sharing"
+	"github.com/zhunismp/intent-products-api/internal/core/infrastructure/transaction"
+)
+
+type installmentService struct {
+	installmentRepo InstallmentRepository
+	productSvc      product.ProductUsecase
+	policy          sharing.AccessPolicy
+	txManager       transaction.TxManager
+	logger          *slog.Logger
+}
+
+func NewInstallmentService(
+	installmentRepo InstallmentRepository,
+	productSvc product.ProductUsecase,
+	policy sharing.AccessPolicy,
+	txManager transaction.TxManager,
+	logger *slog.Logger,
+) InstallmentUsecase {
+	return &installmentService{
+		installmentRepo: installmentRepo,
+		productSvc:      productSvc,
+		policy:          policy,
+		txManager:       txManager,
+		logger:          logger,
+	}
+}
+
+func (s *installmentService) CreatePlan(
+	ctx context.Context,
+	userID uint,
+	productID uint,
+	total float64,
+	months int,
+	startDate time.Time,
+	interestRate float64,
+) (*Plan, error) {
+
+	if err := validateTerms(total, months, interestRate); err != nil {
+		return nil, err
+	}
+
+	// the plan is kept under the list owner, so everyone the list is shared with sees it
+	ownerID, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	var planID uint
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		p, err := s.productSvc.GetProduct(ctx, userID, productID)
+		if err != nil {
+			return err
+		}
+
+		if p.Status == product.BOUGHT {
+			return apperrors.New(
+				apperrors.ErrCodeConflict,
+				fmt.Sprintf("product id %d is already bought", productID),
+				nil,
+			)
+		}
+
+		// fall back to the product price when no total is given
+		if total == 0 {
+			total = p.Price
+		}
+
+		plan := &Plan{
+			ProductID:    productID,
+			OwnerID:      ownerID,
+			Total:        total,
+			Months:       months,
+			StartDate:    startDate,
+			InterestRate: interestRate,
+		}
+
+		planID, err = s.installmentRepo.CreatePlan(ctx, plan)
+		if err != nil {
+			return err
+		}
+
+		if p.Status == product.PENDING {
+			if _, err := s.productSvc.TransitionStatus(ctx, userID, productID, product.INSTALLMENT, nil); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "installment plan created successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Uint64("product_id", uint64(productID)),
+		slog.Group("plan_info",
+			slog.Uint64("id", uint64(planID)),
+			slog.Float64("total", total),
+			slog.Int("months", months),
+			slog.Float64("interest_rate", interestRate),
+		),
+	)
+
+	return s.GetPlan(ctx, userID, productID)
+}
+
+func (s *installmentService) GetPlan(ctx context.Context, userID, productID uint) (*Plan, error) {
+	ownerID, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionView)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := s.getPlan(ctx, ownerID, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "get installment plan successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Uint64("product_id", uint64(productID)),
+		slog.Uint64("plan_id", uint64(plan.ID)),
+	)
+
+	return plan, nil
+}
+
+// getPlan loads the plan of the product stored under the owner with its payments summarized.
+func (s *installmentService) getPlan(ctx context.Context, ownerID, productID uint) (*Plan, error) {
+	plan, err := s.installmentRepo.GetPlanByProductID(ctx, ownerID, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withPayments(ctx, plan)
+}
+
+func (s *installmentService) withPayments(ctx context.Context, plan *Plan) (*Plan, error) {
+	payments, err := s.installmentRepo.FindPaymentsByPlanID(ctx, plan.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.Payments = payments
+	summarize(plan)
+
+	return plan, nil
+}
+
+func (s *installmentService) GetSchedule(ctx context.Context, userID, productID uint) ([]*ScheduleEntry, error) {
+
+	plan, err := s.GetPlan(ctx, userID, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := BuildSchedule(plan.Total, plan.Months, plan.InterestRate, plan.StartDate)
+	MarkPaid(schedule, plan.PaidAmount)
+
+	return schedule, nil
+}
+
+func (s *installmentService) CancelPlan(ctx context.Context, userID, productID uint) (*product.Product, error) {
+	ownerID, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	var p *product.Product
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		// a payment recorded meanwhile would settle a plan that is going away
+		plan, err := s.installmentRepo.LockPlanByProductID(ctx, ownerID, productID)
+		if err != nil {
+			return err
+		}
+
+		current, err := s.productSvc.GetProduct(ctx, userID, productID)
+		if err != nil {
+			return err
+		}
+		if current.Status != product.INSTALLMENT {
+			return apperrors.New(
+				apperrors.ErrCodeConflict,
+				fmt.Sprintf("installment plan id %d of product id %d can not be cancelled once the product is %s", plan.ID, productID, current.Status),
+				nil,
+			)
+		}
+
+		// leaving installment drops the plan with its payments
+		p, err = s.productSvc.TransitionStatus(ctx, userID, productID, product.PENDING, nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "installment plan cancelled successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Uint64("product_id", uint64(productID)),
+	)
+
+	return p, nil
+}
+
+func (s *installmentService) RecordPayment(
+	ctx context.Context,
+	userID uint,
+	productID uint,
+	amount float64,
+	paidAt time.Time,
+) (*Plan, error) {
+
+	amount = roundCents(amount)
+
+	// authorized once for the whole payment, settling the last installment marks the
+	// product bought under the same rights
+	ownerID, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan *Plan
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		p, err := s.productSvc.GetProduct(ctx, userID, productID)
+		if err != nil {
+			return err
+		}
+
+		if p.Status != product.INSTALLMENT {
+			return apperrors.New(
+				apperrors.ErrCodeConflict,
+				fmt.Sprintf("product id %d is not paid by installment", productID),
+				nil,
+			)
+		}
+
+		// concurrent payments wait for each other, so together they can not overpay
+		plan, err = s.installmentRepo.LockPlanByProductID(ctx, ownerID, productID)
+		if err != nil {
+			return err
+		}
+		if plan, err = s.withPayments(ctx, plan); err != nil {
+			return err
+		}
+
+		if plan.IsSettled() {
+			return apperrors.New(
+				apperrors.ErrCodeConflict,
+				fmt.Sprintf("installment plan of product id %d is already settled", productID),
+				nil,
+			)
+		}
+
+		if amount > plan.RemainingBalance {
+			return apperrors.New(
+				apperrors.ErrCodeValidation,
+				fmt.Sprintf("amount exceeds the remaining balance of %.2f", plan.RemainingBalance),
+				nil,
+			)
+		}
+
+		payment := &Payment{
+			PlanID: plan.ID,
+			Amount: amount,
+			PaidAt: paidAt,
+		}
+
+		if err := s.installmentRepo.SavePayment(ctx, payment); err != nil {
+			return err
+		}
+
+		plan.Payments = append(plan.Payments, payment)
+		summarize(plan)
+
+		// last payment recorded, the product is now fully paid. The money is spent, a
+		// cooling-off still running can not hold the purchase back any more.
+		if plan.IsSettled() {
+			if _, err := s.productSvc.SettleStatus(ctx, userID, ownerID, productID, product.BOUGHT); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "installment payment recorded successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Uint64("product_id", uint64(productID)),
+		slog.Group("payment_info",
+			slog.Uint64("plan_id", uint64(plan.ID)),
+			slog.Float64("amount", amount),
+			slog.Float64("remaining_balance", plan.RemainingBalance),
+			slog.Bool("settled", plan.IsSettled()),
+		),
+	)
+
+	return plan, nil
+}
+
+func summarize(plan *Plan) {
+	schedule := BuildSchedule(plan.Total, plan.Months, plan.InterestRate, plan.StartDate)
+
+	totalPayable := 0.0
+	for _, entry := range schedule {
+		totalPayable += entry.Payment
+	}
+
+	paid := 0.0
+	for _, payment := range plan.Payments {
+		paid += payment.Amount
+	}
+
+	plan.MonthlyPayment = monthlyPayment(plan.Total, plan.Months, plan.InterestRate/100/12)
+	plan.TotalPayable = roundCents(totalPayable)
+	plan.PaidAmount = roundCents(paid)
+	plan.RemainingBalance = max(roundCents(plan.TotalPayable-plan.PaidAmount), 0)
+}
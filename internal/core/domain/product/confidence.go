@@ -0,0 +1,27 @@
+package product
+
+import "github.com/zhunismp/intent-products-api/internal/core/domain/cause"
+
+// ConfidenceScore weighs the active causes of a product against each other, pros count for
+// their weight and cons against it, over the weight of all of them. It runs from -1 when
+// only cons apply to 1 when only pros do, a product without active causes scores 0.
+func ConfidenceScore(causes []*cause.Cause) float64 {
+	balance, total := 0, 0
+	for _, c := range causes {
+		if !c.Status {
+			continue
+		}
+
+		total += c.Weight
+		if c.Polarity == cause.PolarityCon {
+			balance -= c.Weight
+		} else {
+			balance += c.Weight
+		}
+	}
+
+	if total == 0 {
+		return 0
+	}
+	return float64(balance) / float64(total)
+}
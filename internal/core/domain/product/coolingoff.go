@@ -0,0 +1,93 @@
+package product
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+// CoolingOff is the wait between adding a product and marking it bought, it only shows on
+// products that are not bought yet and have a wait at all.
+type CoolingOff struct {
+	Hours  int       `json:"hours"`
+	EndsAt time.Time `json:"endsAt"`
+
+	// RemainingSeconds is how long is left at the time the product was read, 0 once over
+	RemainingSeconds int64 `json:"remainingSeconds"`
+}
+
+// NewCoolingOff starts the wait of hours when the product was added, nil when there is none.
+func NewCoolingOff(createdAt time.Time, hours int, now time.Time) *CoolingOff {
+	if hours <= 0 {
+		return nil
+	}
+
+	endsAt := createdAt.Add(time.Duration(hours) * time.Hour)
+	remaining := max(endsAt.Sub(now), 0)
+
+	return &CoolingOff{
+		Hours:  hours,
+		EndsAt: endsAt,
+		// rounded up, a second left is still a wait
+		RemainingSeconds: int64((remaining + time.Second - 1) / time.Second),
+	}
+}
+
+// IsActive reports whether the product still has to wait, it is safe to call on nil.
+func (c *CoolingOff) IsActive() bool {
+	return c != nil && c.RemainingSeconds > 0
+}
+
+func (c *CoolingOff) Remaining() time.Duration {
+	if c == nil {
+		return 0
+	}
+	return time.Duration(c.RemainingSeconds) * time.Second
+}
+
+// Override marks a product bought before its cooling-off ends. The justification is kept
+// on the product as a cause so the early purchase stays explained.
+type Override struct {
+	Justification string
+}
+
+// Validate checks the override is justified, it is safe to call on a nil override.
+func (o *Override) Validate() error {
+	if o == nil {
+		return nil
+	}
+	if strings.TrimSpace(o.Justification) == "" {
+		return apperrors.New(apperrors.ErrCodeValidation, "an override needs a justification", nil)
+	}
+	return nil
+}
+
+// CoolingOffError is wrapped by the error refusing a purchase during the cooling-off, it
+// carries how long is left.
+type CoolingOffError struct {
+	EndsAt    time.Time
+	Remaining time.Duration
+}
+
+func (e *CoolingOffError) Error() string {
+	return fmt.Sprintf("cooling-off ends at %s", e.EndsAt.Format(time.RFC3339))
+}
+
+// CheckCoolingOff refuses moving a product to bought while it is cooling off, unless an
+// override is given. Other statuses are never held back.
+func CheckCoolingOff(p *Product, to string, override *Override) error {
+	if to != BOUGHT || !p.CoolingOff.IsActive() || override != nil {
+		return nil
+	}
+
+	return apperrors.New(
+		apperrors.ErrCodeConflict,
+		fmt.Sprintf(
+			"product id %d is cooling off for another %s, override with a justification to buy it now",
+			p.ID, p.CoolingOff.Remaining(),
+		),
+		&CoolingOffError{EndsAt: p.CoolingOff.EndsAt, Remaining: p.CoolingOff.Remaining()},
+	)
+}
@@ -0,0 +1,65 @@
+package product
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+const (
+	DirectionNext string = "next"
+	DirectionPrev string = "prev"
+)
+
+// Cursor points at a product in the position ordering. Ties on position are broken by id,
+// so a page boundary stays put while other products are moved around it.
+type Cursor struct {
+	Position  string `json:"p"`
+	ID        uint   `json:"i"`
+	Direction string `json:"d"`
+}
+
+// CursorFilter asks for the page that follows or precedes Cursor, or the first page without one.
+// Cursors walk the position order, so the filter can not sort by anything else and Page is ignored.
+type CursorFilter struct {
+	Filter
+	Cursor string
+}
+
+type CursorPage struct {
+	Items      []*Product `json:"items"`
+	NextCursor string     `json:"nextCursor,omitempty"`
+	PrevCursor string     `json:"prevCursor,omitempty"`
+}
+
+// EncodeCursor returns the opaque form of a cursor handed out to clients.
+func EncodeCursor(c Cursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor parses a cursor previously returned by EncodeCursor.
+func DecodeCursor(s string) (*Cursor, error) {
+	invalid := apperrors.New(apperrors.ErrCodeValidation, "cursor is invalid", nil)
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, invalid
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, invalid
+	}
+
+	if c.ID == 0 || (c.Direction != DirectionNext && c.Direction != DirectionPrev) {
+		return nil, invalid
+	}
+
+	return &c, nil
+}
+
+func cursorOf(p *Product, direction string) string {
+	return EncodeCursor(Cursor{Position: p.Position, ID: p.ID, Direction: direction})
+}
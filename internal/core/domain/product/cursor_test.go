@@ -0,0 +1,63 @@
+package product
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor Cursor
+	}{
+		{name: "next", cursor: Cursor{Position: "a0", ID: 1, Direction: DirectionNext}},
+		{name: "prev", cursor: Cursor{Position: "a0V", ID: 42, Direction: DirectionPrev}},
+		{name: "long key", cursor: Cursor{Position: "Zzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzz", ID: 7, Direction: DirectionNext}},
+		{name: "empty position", cursor: Cursor{ID: 3, Direction: DirectionNext}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := EncodeCursor(tt.cursor)
+
+			decoded, err := DecodeCursor(encoded)
+			if err != nil {
+				t.Fatalf("failed to decode %q: %v", encoded, err)
+			}
+			if *decoded != tt.cursor {
+				t.Fatalf("got cursor %+v, want %+v", *decoded, tt.cursor)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorRejectsTampering(t *testing.T) {
+	valid := EncodeCursor(Cursor{Position: "a0", ID: 1, Direction: DirectionNext})
+	encode := func(raw string) string { return base64.RawURLEncoding.EncodeToString([]byte(raw)) }
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "not base64", cursor: "!!not-a-cursor!!"},
+		{name: "truncated", cursor: valid[:len(valid)-4]},
+		{name: "not json", cursor: encode("a0,1,next")},
+		{name: "wrong types", cursor: encode(`{"p":1,"i":"1","d":"next"}`)},
+		{name: "negative id", cursor: encode(`{"p":"a0","i":-1,"d":"next"}`)},
+		{name: "missing id", cursor: encode(`{"p":"a0","d":"next"}`)},
+		{name: "unknown direction", cursor: encode(`{"p":"a0","i":1,"d":"up"}`)},
+		{name: "missing direction", cursor: encode(`{"p":"a0","i":1}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := DecodeCursor(tt.cursor)
+			// a bad cursor is the client's mistake, never a server error
+			if !apperrors.IsCode(err, apperrors.ErrCodeValidation) {
+				t.Fatalf("got cursor %+v and error %v, want a validation error", c, err)
+			}
+		})
+	}
+}
@@ -1,22 +1,35 @@
 package product
 
 import (
+	"errors"
 	"time"
 
 	"github.com/zhunismp/intent-products-api/internal/core/domain/cause"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/tag"
 )
 
 // TODO: when logic is complex, should not return domain object directly
 type Product struct {
-	ID       uint           `json:"id"`
-	OwnerID  uint           `json:"ownerId"`
-	Name     string         `json:"name"`
-	ImageUrl string         `json:"imageUrl"`
-	Link     string         `json:"link"`
-	Price    float64        `json:"price"`
-	Status   string         `json:"status"`
-	Position string         `json:"-"`
-	Causes   []*cause.Cause `json:"causes,omitempty"`
+	ID       uint    `json:"id"`
+	OwnerID  uint    `json:"ownerId"`
+	ListID   uint    `json:"listId"`
+	Name     string  `json:"name"`
+	ImageUrl string  `json:"imageUrl"`
+	Link     string  `json:"link"`
+	Price    float64 `json:"price"`
+	Status   string  `json:"status"`
+	Position string  `json:"-"`
+
+	// Confidence is the ConfidenceScore of the causes, kept up to date as they change
+	Confidence float64 `json:"confidence"`
+
+	// CoolingOffHours is the product's own cooling-off, nil follows the owner's default
+	CoolingOffHours *int `json:"coolingOffHours"`
+	// CoolingOff is the wait that applies when the product was read
+	CoolingOff *CoolingOff `json:"coolingOff,omitempty"`
+
+	Causes []*cause.Cause `json:"causes,omitempty"`
+	Tags   []*tag.Tag     `json:"tags,omitempty"`
 
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
@@ -27,3 +40,11 @@ const (
 	INSTALLMENT string = "installment"
 	BOUGHT      string = "bought"
 )
+
+// PositionSpread is how many candidate keys a new position is picked from at random, so
+// concurrent writes into the same gap rarely pick the same key.
+const PositionSpread uint = 8
+
+// ErrPositionTaken is wrapped by repositories when another product of the list already
+// holds the position, a concurrent write got there first.
+var ErrPositionTaken = errors.New("position is already taken")
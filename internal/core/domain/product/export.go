@@ -0,0 +1,64 @@
+package product
+
+import (
+	"fmt"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/settings"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/tag"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/wishlist"
+)
+
+// ListExport is a wishlist of an owner with its products in position order, the unit moved
+// by export and import. Products carry their causes and their tags, tags are matched by
+// name when imported.
+type ListExport struct {
+	List     *wishlist.Wishlist
+	Products []*Product
+}
+
+// validateImport checks every list and product before anything is written. Missing
+// statuses default to pending.
+func validateImport(lists []*ListExport) error {
+	n := 0
+	for i, l := range lists {
+		if l.List == nil {
+			return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("list %d is missing", i+1), nil)
+		}
+		if !l.List.IsDefault {
+			patch := &wishlist.WishlistPatch{Name: &l.List.Name, Emoji: &l.List.Emoji, Color: &l.List.Color}
+			if err := patch.Validate(); err != nil {
+				return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("list %d is invalid", i+1), err)
+			}
+		}
+
+		for _, p := range l.Products {
+			n++
+			if p.Name == "" || len(p.Name) > 255 {
+				return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("product %d needs a name of at most 255 characters", n), nil)
+			}
+			if p.Price < 0 {
+				return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("product %d has a negative price", n), nil)
+			}
+			if p.Status == "" {
+				p.Status = PENDING
+			}
+			if !IsValidStatus(p.Status) {
+				return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("product %d has invalid status %q", n, p.Status), nil)
+			}
+			if p.CoolingOffHours != nil {
+				if err := settings.ValidateCoolingOffHours(*p.CoolingOffHours); err != nil {
+					return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("product %d has an invalid cooling-off", n), err)
+				}
+			}
+			for _, t := range p.Tags {
+				name, err := tag.NormalizeTagName(t.Name)
+				if err != nil {
+					return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("product %d has an invalid tag", n), err)
+				}
+				t.Name = name
+			}
+		}
+	}
+	return nil
+}
@@ -1,7 +1,100 @@
 package product
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+const (
+	SortPosition   string = "position"
+	SortPrice      string = "price"
+	SortCreatedAt  string = "createdAt"
+	SortName       string = "name"
+	SortConfidence string = "confidence"
+)
+
+// Filter selects, orders and pages the products of an owner. Every criterion is optional,
+// an empty filter lists everything in position order.
 type Filter struct {
-	Status string
-	Page   int
-	Size   int
+	// ListID keeps the products of one wishlist
+	ListID *uint
+
+	// TagIDs keeps the products carrying at least one of the tags
+	TagIDs []uint
+
+	Statuses []string
+
+	MinPrice *float64
+	MaxPrice *float64
+
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+
+	// Name matches products whose name contains it, ignoring case
+	Name string
+
+	// HasActiveCauses keeps products with at least one active cause when true, without any when false
+	HasActiveCauses *bool
+
+	SortBy   string
+	SortDesc bool
+
+	Page int
+	Size int
+}
+
+// IsValidSort reports whether the list can be ordered by field.
+func IsValidSort(field string) bool {
+	switch field {
+	case SortPosition, SortPrice, SortCreatedAt, SortName, SortConfidence:
+		return true
+	default:
+		return false
+	}
+}
+
+// Validate checks the criteria are consistent, it is safe to call on a nil filter.
+func (f *Filter) Validate() error {
+	if f == nil {
+		return nil
+	}
+
+	for _, status := range f.Statuses {
+		if !IsValidStatus(status) {
+			return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("status %q is invalid", status), nil)
+		}
+	}
+
+	if f.MinPrice != nil && *f.MinPrice < 0 {
+		return apperrors.New(apperrors.ErrCodeValidation, "minimum price can not be negative", nil)
+	}
+	if f.MinPrice != nil && f.MaxPrice != nil && *f.MinPrice > *f.MaxPrice {
+		return apperrors.New(apperrors.ErrCodeValidation, "minimum price is greater than maximum price", nil)
+	}
+
+	if f.CreatedFrom != nil && f.CreatedTo != nil && f.CreatedFrom.After(*f.CreatedTo) {
+		return apperrors.New(apperrors.ErrCodeValidation, "created range starts after it ends", nil)
+	}
+	if f.UpdatedFrom != nil && f.UpdatedTo != nil && f.UpdatedFrom.After(*f.UpdatedTo) {
+		return apperrors.New(apperrors.ErrCodeValidation, "updated range starts after it ends", nil)
+	}
+
+	if f.SortBy != "" && !IsValidSort(f.SortBy) {
+		return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("sort %q is invalid", f.SortBy), nil)
+	}
+
+	if f.Page < 0 || f.Size < 0 {
+		return apperrors.New(apperrors.ErrCodeValidation, "page and size can not be negative", nil)
+	}
+
+	return nil
+}
+
+// IsManualOrder reports whether the filter keeps the owner's manual position order.
+func (f *Filter) IsManualOrder() bool {
+	return (f.SortBy == "" || f.SortBy == SortPosition) && !f.SortDesc
 }
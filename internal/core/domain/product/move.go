@@ -0,0 +1,72 @@
+package product
+
+import (
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+const (
+	MoveBefore string = "before"
+	MoveAfter  string = "after"
+	MoveTop    string = "top"
+	MoveBottom string = "bottom"
+	MoveIndex  string = "index"
+)
+
+// MoveCommand places a product relative to another product of the same owner, at either end
+// of a list, or at a zero based index. The moving product never counts as its own neighbour,
+// an index past the end moves it to the bottom. A product moved before or after a product of
+// another list joins that list.
+type MoveCommand struct {
+	ProductID uint
+	Mode      string
+
+	// ListID is the list to move into for top, bottom and index, the product's own list when nil
+	ListID *uint
+
+	// TargetID is the product to move before or after
+	TargetID *uint
+
+	// Index is the place the product ends up at in the list
+	Index *int
+}
+
+// IsValidMoveMode reports whether mode is one of the supported move modes.
+func IsValidMoveMode(mode string) bool {
+	switch mode {
+	case MoveBefore, MoveAfter, MoveTop, MoveBottom, MoveIndex:
+		return true
+	default:
+		return false
+	}
+}
+
+// Validate checks the mode has what it needs, it is safe to call on a nil command.
+func (m *MoveCommand) Validate() error {
+	if m == nil || m.ProductID == 0 {
+		return apperrors.New(apperrors.ErrCodeValidation, "product id is required", nil)
+	}
+	if !IsValidMoveMode(m.Mode) {
+		return apperrors.New(apperrors.ErrCodeValidation, "move mode is invalid", nil)
+	}
+
+	switch m.Mode {
+	case MoveBefore, MoveAfter:
+		if m.TargetID == nil || *m.TargetID == 0 {
+			return apperrors.New(apperrors.ErrCodeValidation, "target id is required to move "+m.Mode+" a product", nil)
+		}
+		if *m.TargetID == m.ProductID {
+			return apperrors.New(apperrors.ErrCodeValidation, "a product can not be moved relative to itself", nil)
+		}
+		if m.ListID != nil {
+			return apperrors.New(apperrors.ErrCodeValidation, "list id only applies to top, bottom and index, the target decides the list otherwise", nil)
+		}
+	case MoveIndex:
+		if m.Index == nil {
+			return apperrors.New(apperrors.ErrCodeValidation, "index is required to move a product to an index", nil)
+		}
+		if *m.Index < 0 {
+			return apperrors.New(apperrors.ErrCodeValidation, "index must not be negative", nil)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,337 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/utils/ordering"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/sharing"
+)
+
+// memoryProductRepo keeps the products of a single list in memory. Like the unique index on
+// (list_id, position) it refuses a position another product already holds, so concurrent
+// moves into the same gap lose with ErrPositionTaken. Methods a move does not use are left
+// to the embedded nil interface.
+type memoryProductRepo struct {
+	ProductRepository
+
+	mu       sync.Mutex
+	products map[uint]*Product
+
+	// failures makes the next updates lose the position race
+	failures atomic.Int32
+	updates  atomic.Int32
+	// conflicts counts the updates refused because another product held the position
+	conflicts atomic.Int32
+
+	// pause widens the window between reading the neighbours and writing, so concurrent
+	// moves really meet
+	pause time.Duration
+}
+
+func newMemoryProductRepo(t *testing.T, ownerID, listID uint, n int) *memoryProductRepo {
+	t.Helper()
+
+	keys, err := ordering.NKeysBetween("", "", uint(n))
+	if err != nil {
+		t.Fatalf("failed to generate positions: %v", err)
+	}
+
+	repo := &memoryProductRepo{products: make(map[uint]*Product, n)}
+	for i, key := range keys {
+		id := uint(i + 1)
+		repo.products[id] = &Product{ID: id, OwnerID: ownerID, ListID: listID, Position: key}
+	}
+	return repo
+}
+
+func (r *memoryProductRepo) GetProduct(_ context.Context, ownerID, productID uint) (*Product, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.products[productID]
+	if !ok || p.OwnerID != ownerID {
+		return nil, apperrors.New(apperrors.ErrCodeNotFound, fmt.Sprintf("product id %d not found", productID), nil)
+	}
+	copied := *p
+	return &copied, nil
+}
+
+func (r *memoryProductRepo) LockProduct(ctx context.Context, ownerID, productID uint) error {
+	_, err := r.GetProduct(ctx, ownerID, productID)
+	return err
+}
+
+// sorted returns the positions of the list in order, leaving excludeID out.
+func (r *memoryProductRepo) sorted(listID, excludeID uint) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	positions := make([]string, 0, len(r.products))
+	for _, p := range r.products {
+		if p.ListID == listID && p.ID != excludeID {
+			positions = append(positions, p.Position)
+		}
+	}
+	sort.Strings(positions)
+	return positions
+}
+
+func (r *memoryProductRepo) GetFirstPosition(_ context.Context, listID, excludeID uint) (string, error) {
+	positions := r.sorted(listID, excludeID)
+	if len(positions) == 0 {
+		return "", nil
+	}
+	return positions[0], nil
+}
+
+func (r *memoryProductRepo) GetLastPosition(_ context.Context, listID, excludeID uint) (string, error) {
+	positions := r.sorted(listID, excludeID)
+	if len(positions) == 0 {
+		return "", nil
+	}
+	return positions[len(positions)-1], nil
+}
+
+func (r *memoryProductRepo) GetNextPosition(_ context.Context, listID, excludeID uint, position string) (string, error) {
+	for _, p := range r.sorted(listID, excludeID) {
+		if p > position {
+			return p, nil
+		}
+	}
+	return "", nil
+}
+
+func (r *memoryProductRepo) GetPrevPosition(_ context.Context, listID, excludeID uint, position string) (string, error) {
+	positions := r.sorted(listID, excludeID)
+	for i := len(positions) - 1; i >= 0; i-- {
+		if positions[i] < position {
+			return positions[i], nil
+		}
+	}
+	return "", nil
+}
+
+func (r *memoryProductRepo) GetPositionAt(_ context.Context, listID, excludeID uint, index int) (string, error) {
+	positions := r.sorted(listID, excludeID)
+	if index >= len(positions) {
+		return "", nil
+	}
+	return positions[index], nil
+}
+
+func (r *memoryProductRepo) UpdatePosition(_ context.Context, ownerID, productID, listID uint, position string) error {
+	r.updates.Add(1)
+	if r.failures.Add(-1) >= 0 {
+		return fmt.Errorf("injected race: %w", ErrPositionTaken)
+	}
+	if r.pause > 0 {
+		time.Sleep(rand.N(r.pause))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, p := range r.products {
+		if p.ListID == listID && p.ID != productID && p.Position == position {
+			r.conflicts.Add(1)
+			return fmt.Errorf("product id %d holds %q: %w", p.ID, position, ErrPositionTaken)
+		}
+	}
+
+	p, ok := r.products[productID]
+	if !ok || p.OwnerID != ownerID {
+		return apperrors.New(apperrors.ErrCodeNotFound, fmt.Sprintf("product id %d not found", productID), nil)
+	}
+	p.ListID, p.Position = listID, position
+	return nil
+}
+
+// order returns the ids of the list in position order.
+func (r *memoryProductRepo) order(listID uint) []*Product {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	products := make([]*Product, 0, len(r.products))
+	for _, p := range r.products {
+		if p.ListID == listID {
+			copied := *p
+			products = append(products, &copied)
+		}
+	}
+	slices.SortFunc(products, func(a, b *Product) int {
+		switch {
+		case a.Position < b.Position:
+			return -1
+		case a.Position > b.Position:
+			return 1
+		}
+		return 0
+	})
+	return products
+}
+
+// ownerPolicy lets every user act on their own products only.
+type ownerPolicy struct{}
+
+func (ownerPolicy) AuthorizeList(_ context.Context, userID, _ uint, _ sharing.Action) (uint, error) {
+	return userID, nil
+}
+
+func (ownerPolicy) AuthorizeProduct(_ context.Context, userID, _ uint, _ sharing.Action) (uint, error) {
+	return userID, nil
+}
+
+// directTx runs the unit of work as is, the memory repository has nothing to roll back.
+type directTx struct{}
+
+func (directTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newMoveTestService(repo ProductRepository) *productService {
+	return &productService{
+		productRepo: repo,
+		policy:      ownerPolicy{},
+		txManager:   directTx{},
+		logger:      slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+// assertTotalOrder checks every product of the list kept a distinct valid key, so the
+// list still has exactly one order.
+func assertTotalOrder(t *testing.T, repo *memoryProductRepo, listID uint, want int) {
+	t.Helper()
+
+	products := repo.order(listID)
+	if len(products) != want {
+		t.Fatalf("list holds %d products, want %d", len(products), want)
+	}
+
+	prev := ""
+	for _, p := range products {
+		// a key fits after the previous one only when both are valid and strictly ordered
+		if _, err := ordering.KeyBetween(prev, p.Position); err != nil {
+			t.Fatalf("product id %d at %q does not follow %q: %v", p.ID, p.Position, prev, err)
+		}
+		prev = p.Position
+	}
+}
+
+func TestMoveConcurrentKeepsTotalOrder(t *testing.T) {
+	const (
+		ownerID   = 1
+		listID    = 1
+		products  = 40
+		workers   = 16
+		movesEach = 25
+	)
+
+	repo := newMemoryProductRepo(t, ownerID, listID, products)
+	repo.pause = time.Millisecond
+	svc := newMoveTestService(repo)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make(chan error, workers*movesEach)
+	for w := range workers {
+		wg.Add(1)
+		go func(seed uint64) {
+			defer wg.Done()
+			rng := rand.New(rand.NewPCG(seed, seed))
+			<-start
+
+			for range movesEach {
+				id := uint(rng.IntN(products) + 1)
+				cmd := &MoveCommand{ProductID: id}
+
+				switch rng.IntN(5) {
+				case 0:
+					cmd.Mode = MoveTop
+				case 1:
+					cmd.Mode = MoveBottom
+				case 2:
+					index := rng.IntN(products + 2)
+					cmd.Mode, cmd.Index = MoveIndex, &index
+				default:
+					target := uint(rng.IntN(products) + 1)
+					if target == id {
+						target = id%products + 1
+					}
+					cmd.Mode, cmd.TargetID = MoveBefore, &target
+					if rng.IntN(2) == 0 {
+						cmd.Mode = MoveAfter
+					}
+				}
+
+				if err := svc.Move(ctx, ownerID, cmd); err != nil {
+					errs <- fmt.Errorf("move %s of product id %d: %w", cmd.Mode, id, err)
+				}
+			}
+		}(uint64(w))
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+	t.Logf("%d moves wrote %d times, %d lost the position race", workers*movesEach, repo.updates.Load(), repo.conflicts.Load())
+	assertTotalOrder(t, repo, listID, products)
+}
+
+func TestMoveRetriesLostPositionRace(t *testing.T) {
+	const ownerID, listID = 1, 1
+
+	repo := newMemoryProductRepo(t, ownerID, listID, 5)
+	repo.failures.Store(positionAttempts - 1)
+	svc := newMoveTestService(repo)
+
+	target := uint(2)
+	err := svc.Move(context.Background(), ownerID, &MoveCommand{ProductID: 5, Mode: MoveAfter, TargetID: &target})
+	if err != nil {
+		t.Fatalf("move failed after lost races: %v", err)
+	}
+	if got := repo.updates.Load(); got != positionAttempts {
+		t.Fatalf("move wrote %d times, want %d", got, positionAttempts)
+	}
+
+	ids := make([]uint, 0, 5)
+	for _, p := range repo.order(listID) {
+		ids = append(ids, p.ID)
+	}
+	if want := []uint{1, 2, 5, 3, 4}; !slices.Equal(ids, want) {
+		t.Fatalf("order is %v, want %v", ids, want)
+	}
+	assertTotalOrder(t, repo, listID, 5)
+}
+
+func TestMoveGivesUpAfterRepeatedRaces(t *testing.T) {
+	const ownerID, listID = 1, 1
+
+	repo := newMemoryProductRepo(t, ownerID, listID, 3)
+	repo.failures.Store(positionAttempts)
+	svc := newMoveTestService(repo)
+
+	err := svc.Move(context.Background(), ownerID, &MoveCommand{ProductID: 3, Mode: MoveTop})
+	if !errors.Is(err, ErrPositionTaken) {
+		t.Fatalf("move returned %v, want ErrPositionTaken", err)
+	}
+	if got := repo.updates.Load(); got != positionAttempts {
+		t.Fatalf("move wrote %d times, want %d", got, positionAttempts)
+	}
+	assertTotalOrder(t, repo, listID, 3)
+}
@@ -0,0 +1,94 @@
+package product
+
+import (
+	"fmt"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/settings"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+// MinPrice is the lowest price a product can be created with or changed to.
+const MinPrice = 1
+
+// ValidatePrice checks price is a price a product can have, every adapter relies on it.
+func ValidatePrice(price float64) error {
+	if price < MinPrice {
+		return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("price must be at least %d", MinPrice), nil)
+	}
+	return nil
+}
+
+// Patch describes a partial update of a product. Nil fields are left untouched.
+type Patch struct {
+	Name     *string
+	Price    *float64
+	Link     *string
+	ImageUrl *string
+	Status   *string
+
+	CoolingOffHours *int
+	// InheritCoolingOff drops the product's own cooling-off for the owner's default
+	InheritCoolingOff bool
+}
+
+func (p *Patch) IsEmpty() bool {
+	return p == nil ||
+		p.Name == nil &&
+			p.Price == nil &&
+			p.Link == nil &&
+			p.ImageUrl == nil &&
+			p.Status == nil &&
+			p.CoolingOffHours == nil &&
+			!p.InheritCoolingOff
+}
+
+func (p *Patch) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.Name != nil && *p.Name == "" {
+		return apperrors.New(apperrors.ErrCodeValidation, "name must not be empty", nil)
+	}
+	if p.Price != nil {
+		if err := ValidatePrice(*p.Price); err != nil {
+			return err
+		}
+	}
+	if p.Status != nil && !IsValidStatus(*p.Status) {
+		return apperrors.New(apperrors.ErrCodeValidation, "status is invalid", nil)
+	}
+	if p.CoolingOffHours != nil {
+		if p.InheritCoolingOff {
+			return apperrors.New(apperrors.ErrCodeValidation, "cooling-off can not be set and inherited at once", nil)
+		}
+		if err := settings.ValidateCoolingOffHours(*p.CoolingOffHours); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Patch) ApplyTo(product *Product) {
+	if p.Name != nil {
+		product.Name = *p.Name
+	}
+	if p.Price != nil {
+		product.Price = *p.Price
+	}
+	if p.Link != nil {
+		product.Link = *p.Link
+	}
+	if p.ImageUrl != nil {
+		product.ImageUrl = *p.ImageUrl
+	}
+	if p.Status != nil {
+		product.Status = *p.Status
+	}
+	if p.CoolingOffHours != nil {
+		hours := *p.CoolingOffHours
+		product.CoolingOffHours = &hours
+	}
+	if p.InheritCoolingOff {
+		product.CoolingOffHours = nil
+	}
+}
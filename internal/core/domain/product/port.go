@@ -2,29 +2,109 @@ package product
 
 import (
 	"context"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/cause"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/tag"
 )
 
+// ProductUsecase acts on behalf of a user. Product and list level calls also reach the lists
+// others shared with the user, as far as the user's role allows, the rest stays with the
+// user's own products.
 type ProductUsecase interface {
-	CreateProduct(ctx context.Context, ownerID uint, title string, price float64, link string, reasons []string) error
-	GetProduct(ctx context.Context, ownerID uint, productID uint) (*Product, error)
-	GetAllProducts(ctx context.Context, ownerID uint, filter *Filter) ([]*Product, error)
-	Move(ctx context.Context, ownerID uint, productID uint, productAfterID *uint) error
-	DeleteProduct(ctx context.Context, ownerID uint, productID uint) error
+	// CreateProduct appends a product to the list, the user's default list when listID is 0.
+	// Without its own cooling-off the product follows the owner's default.
+	CreateProduct(ctx context.Context, userID uint, listID uint, title string, price float64, link string, reasons []string, coolingOffHours *int) error
+	GetProduct(ctx context.Context, userID uint, productID uint) (*Product, error)
+	GetAllProducts(ctx context.Context, userID uint, filter *Filter) (*ProductPage, error)
+	GetSummary(ctx context.Context, ownerID uint) (*ProductSummary, error)
+	GetProductsPage(ctx context.Context, userID uint, filter *CursorFilter) (*CursorPage, error)
+	UpdateProduct(ctx context.Context, userID uint, productID uint, patch *Patch) (*Product, error)
+	// TransitionStatus refuses moving to bought during the cooling-off unless overridden, the
+	// override's justification is added to the product as a cause. Moving from installment
+	// back to pending drops the product's installment plan.
+	TransitionStatus(ctx context.Context, userID uint, productID uint, status string, override *Override) (*Product, error)
+	// SettleStatus is the transition of a purchase settled elsewhere, like a paid off
+	// installment plan. The cooling-off does not hold it back and no cause is added. The
+	// caller already authorized the user to edit the product and resolved its owner.
+	SettleStatus(ctx context.Context, userID uint, ownerID uint, productID uint, status string) (*Product, error)
+	GetStatusHistory(ctx context.Context, userID uint, productID uint) ([]*StatusChange, error)
+	Move(ctx context.Context, userID uint, cmd *MoveCommand) error
+	// Reorder applies a complete order of a list's products and returns how many moved. The
+	// user's default list is used when listID is 0.
+	Reorder(ctx context.Context, userID uint, listID uint, productIDs []uint) (int, error)
+	DeleteProduct(ctx context.Context, userID uint, productID uint) error
+
+	// ExportWishlists returns every list of the owner, archived ones included, with its
+	// products in position order. Products carry their causes and tags.
+	ExportWishlists(ctx context.Context, ownerID uint) ([]*ListExport, error)
+	// ImportWishlists appends the products of each list to the matching list of the owner, in
+	// order, and returns how many were imported. Lists and tags the owner lacks are created.
+	ImportWishlists(ctx context.Context, ownerID uint, lists []*ListExport) (int, error)
+	// RebalancePositions spreads the position keys of every list of the owner evenly again,
+	// keeping the order.
+	RebalancePositions(ctx context.Context, ownerID uint) (int, error)
 
-	AddCauses(ctx context.Context, ownerID uint, productID uint, reasons []string) error
+	// AddCauses adds reasons sharing one polarity and weight, an empty polarity and a zero
+	// weight add pros of average weight.
+	AddCauses(ctx context.Context, userID uint, productID uint, reasons []string, polarity string, weight int) error
+	// UpdateCause changes the reason of a cause, how it weighs or whether it still applies.
+	UpdateCause(ctx context.Context, userID uint, productID uint, causeID uint, patch *cause.CausePatch) (*cause.Cause, error)
+	DeleteCause(ctx context.Context, userID uint, productID uint, causeID uint) error
+	// ReorderCauses applies a complete order of the product's causes and returns them in it.
+	ReorderCauses(ctx context.Context, userID uint, productID uint, causeIDs []uint) ([]*cause.Cause, error)
+
+	// TagProduct adds tags of the product's owner to the product and returns all its tags.
+	TagProduct(ctx context.Context, userID uint, productID uint, tagIDs []uint) ([]*tag.Tag, error)
+	UntagProduct(ctx context.Context, userID uint, productID uint, tagID uint) error
+}
+
+// PlanCleaner removes the installment plans of a product that is deleted or no longer paid
+// by installment. Installments build on products, so the product service only reaches them
+// through this port.
+type PlanCleaner interface {
+	DeletePlansByProductID(ctx context.Context, productID uint) error
 }
 
 type ProductRepository interface {
 	CreateProduct(ctx context.Context, product *Product) (uint, error)
 	GetProduct(ctx context.Context, ownerID uint, productID uint) (*Product, error)
 	FindAllProducts(ctx context.Context, ownerID uint, filter *Filter) ([]*Product, error)
+	// FindAllByOwner returns every product of the owner list by list in position order, unpaged.
+	FindAllByOwner(ctx context.Context, ownerID uint) ([]*Product, error)
+	// FindAllByList returns every product of the list in position order, unpaged.
+	FindAllByList(ctx context.Context, listID uint) ([]*Product, error)
+	CountProducts(ctx context.Context, ownerID uint, filter *Filter) (int64, error)
+	SummarizeByStatus(ctx context.Context, ownerID uint) ([]*StatusSummary, error)
+	// SummarizeByTag returns a row for every tag of the owner, in name order.
+	SummarizeByTag(ctx context.Context, ownerID uint) ([]*TagSummary, error)
+	// FindProductsPage returns up to limit products following the cursor in position order,
+	// or preceding it when the cursor direction is prev. Results are always in position order.
+	FindProductsPage(ctx context.Context, ownerID uint, filter *Filter, cursor *Cursor, limit int) ([]*Product, error)
+	UpdateProduct(ctx context.Context, product *Product) (*Product, error)
+	UpdateStatus(ctx context.Context, ownerID uint, productID uint, from string, to string) error
+	UpdateConfidence(ctx context.Context, ownerID uint, productID uint, confidence float64) error
 	DeleteProduct(ctx context.Context, ownerID uint, productID uint) error
 
-	GetFirstPosition(ctx context.Context, ownerID uint) (string, error)
-	GetLastPosition(ctx context.Context, ownerID uint) (string, error)
-	GetPositionByProductID(ctx context.Context, ownerID uint, productID uint) (string, error)
-	GetNextPosition(ctx context.Context, ownerID uint, position string) (string, error)
-	UpdatePosition(ctx context.Context, ownerID uint, productID uint, position string) error
+	// Positions are ordered within a list. The neighbour lookups below skip excludeID, pass
+	// the moving product so it is never its own neighbour, or 0 to consider every product.
+	// They return "" when there is no neighbour.
+	GetFirstPosition(ctx context.Context, listID uint, excludeID uint) (string, error)
+	GetLastPosition(ctx context.Context, listID uint, excludeID uint) (string, error)
+	GetNextPosition(ctx context.Context, listID uint, excludeID uint, position string) (string, error)
+	GetPrevPosition(ctx context.Context, listID uint, excludeID uint, position string) (string, error)
+	// GetPositionAt returns the position at the zero based index of the list.
+	GetPositionAt(ctx context.Context, listID uint, excludeID uint, index int) (string, error)
+	// UpdatePosition places the product in the list at position, the list may be another one.
+	UpdatePosition(ctx context.Context, ownerID uint, productID uint, listID uint, position string) error
+	// ReplacePositions rewrites several positions of a list at once, keys may move between the products.
+	ReplacePositions(ctx context.Context, listID uint, positions map[uint]string) error
+	// LockProduct holds the product row until the transaction ends.
+	LockProduct(ctx context.Context, ownerID uint, productID uint) error
+	// LockPositions holds every product row of the list until the transaction ends.
+	LockPositions(ctx context.Context, listID uint) error
+}
 
-	ValidateOwnership(ctx context.Context, ownerID uint, productID uint) error
+type StatusHistoryRepository interface {
+	SaveStatusChange(ctx context.Context, change *StatusChange) error
+	FindByProductID(ctx context.Context, productID uint) ([]*StatusChange, error)
 }
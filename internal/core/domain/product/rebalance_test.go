@@ -0,0 +1,113 @@
+package product
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/utils/ordering"
+)
+
+func (r *memoryProductRepo) LockPositions(_ context.Context, _ uint) error {
+	return nil
+}
+
+func (r *memoryProductRepo) FindAllByList(_ context.Context, listID uint) ([]*Product, error) {
+	return r.order(listID), nil
+}
+
+func (r *memoryProductRepo) ReplacePositions(_ context.Context, _ uint, positions map[uint]string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, position := range positions {
+		r.products[id].Position = position
+	}
+	return nil
+}
+
+func TestMoveRebalancesLongKeys(t *testing.T) {
+	const (
+		ownerID  = 1
+		listID   = 1
+		products = 6
+		moves    = 60
+		// limit is well below the keys the moves grow without one
+		limit = 5
+	)
+
+	tests := []struct {
+		name              string
+		maxPositionLength int
+		wantRebalance     bool
+	}{
+		{name: "no limit", maxPositionLength: 0},
+		{name: "limit never reached", maxPositionLength: 255},
+		{name: "limit reached", maxPositionLength: limit, wantRebalance: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemoryProductRepo(t, ownerID, listID, products)
+			svc := newMoveTestService(repo)
+			svc.maxPositionLength = tt.maxPositionLength
+
+			want := make([]uint, 0, products)
+			for id := range uint(products) {
+				want = append(want, id+1)
+			}
+
+			// the last product keeps landing right after the first one, so the gap there
+			// shrinks and its keys grow
+			longest, rebalanced := 0, false
+			for range moves {
+				last := want[len(want)-1]
+				target := want[0]
+				if err := svc.Move(context.Background(), ownerID, &MoveCommand{ProductID: last, Mode: MoveAfter, TargetID: &target}); err != nil {
+					t.Fatalf("move failed: %v", err)
+				}
+				want = slices.Insert(want[:len(want)-1], 1, last)
+
+				order := repo.order(listID)
+				ids := make([]uint, 0, len(order))
+				for _, p := range order {
+					ids = append(ids, p.ID)
+					longest = max(longest, len(p.Position))
+				}
+				if !slices.Equal(ids, want) {
+					t.Fatalf("order is %v, want %v", ids, want)
+				}
+				if isEvenlySpread(t, order) {
+					rebalanced = true
+				}
+				if tt.maxPositionLength > 0 && longest > tt.maxPositionLength {
+					t.Fatalf("a key grew to %d characters past the limit of %d", longest, tt.maxPositionLength)
+				}
+			}
+
+			if !tt.wantRebalance && longest <= limit {
+				t.Fatalf("keys only grew to %d characters, the moves never reach the limit", longest)
+			}
+			if rebalanced != tt.wantRebalance {
+				t.Fatalf("rebalanced is %v, want %v (longest key %d characters)", rebalanced, tt.wantRebalance, longest)
+			}
+			assertTotalOrder(t, repo, listID, products)
+		})
+	}
+}
+
+// isEvenlySpread reports whether the products hold exactly the keys a rebalance hands out.
+func isEvenlySpread(t *testing.T, order []*Product) bool {
+	t.Helper()
+
+	keys, err := ordering.NKeysBetween("", "", uint(len(order)))
+	if err != nil {
+		t.Fatalf("failed to generate positions: %v", err)
+	}
+	for i, p := range order {
+		if p.Position != keys[i] {
+			return false
+		}
+	}
+	return true
+}
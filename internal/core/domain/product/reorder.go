@@ -0,0 +1,75 @@
+package product
+
+import (
+	"fmt"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+// ValidateReorder checks ids names every product in current exactly once.
+func ValidateReorder(current []*Product, ids []uint) error {
+	if len(ids) != len(current) {
+		return apperrors.New(
+			apperrors.ErrCodeValidation,
+			fmt.Sprintf("order lists %d products but the list has %d", len(ids), len(current)),
+			nil,
+		)
+	}
+
+	owned := make(map[uint]bool, len(current))
+	for _, p := range current {
+		owned[p.ID] = true
+	}
+
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		if !owned[id] {
+			return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("product id %d is not in the list", id), nil)
+		}
+		if seen[id] {
+			return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("product id %d is listed more than once", id), nil)
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
+
+// keptInOrder marks the longest run of seq, not necessarily contiguous, that is already
+// increasing. Those entries can keep their positions, every other one needs a new key.
+func keptInOrder(seq []int) []bool {
+	// tails[k] is the index into seq ending the best increasing run of length k+1 so far
+	tails := make([]int, 0, len(seq))
+	prev := make([]int, len(seq))
+
+	for i, v := range seq {
+		lo, hi := 0, len(tails)
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if seq[tails[mid]] < v {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+
+		prev[i] = -1
+		if lo > 0 {
+			prev[i] = tails[lo-1]
+		}
+		if lo == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[lo] = i
+		}
+	}
+
+	kept := make([]bool, len(seq))
+	if len(tails) == 0 {
+		return kept
+	}
+	for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+		kept[i] = true
+	}
+	return kept
+}
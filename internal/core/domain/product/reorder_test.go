@@ -0,0 +1,102 @@
+package product
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/utils/ordering"
+)
+
+func TestReorderedPositions(t *testing.T) {
+	tests := []struct {
+		name      string
+		ids       []uint
+		wantMoved int
+	}{
+		{name: "same order", ids: []uint{1, 2, 3, 4, 5}, wantMoved: 0},
+		{name: "swap neighbours", ids: []uint{2, 1, 3, 4, 5}, wantMoved: 1},
+		{name: "last to the top", ids: []uint{5, 1, 2, 3, 4}, wantMoved: 1},
+		{name: "first to the bottom", ids: []uint{2, 3, 4, 5, 1}, wantMoved: 1},
+		{name: "reversed", ids: []uint{5, 4, 3, 2, 1}, wantMoved: 4},
+		{name: "run between kept products", ids: []uint{1, 5, 4, 2, 3}, wantMoved: 2},
+		{name: "interleaved", ids: []uint{3, 1, 4, 2, 5}, wantMoved: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := ordering.NKeysBetween("", "", 5)
+			if err != nil {
+				t.Fatalf("failed to generate positions: %v", err)
+			}
+			current := make([]*Product, 0, len(keys))
+			position := make(map[uint]string, len(keys))
+			for i, key := range keys {
+				id := uint(i + 1)
+				current = append(current, &Product{ID: id, Position: key})
+				position[id] = key
+			}
+
+			if err := ValidateReorder(current, tt.ids); err != nil {
+				t.Fatalf("order %v is invalid: %v", tt.ids, err)
+			}
+			changed, err := reorderedPositions(current, tt.ids)
+			if err != nil {
+				t.Fatalf("failed to reorder: %v", err)
+			}
+
+			// the longest run already in order keeps its keys, only the rest moves
+			if len(changed) != tt.wantMoved {
+				t.Fatalf("moved %d products, want %d: %v", len(changed), tt.wantMoved, changed)
+			}
+
+			// a moved product lands strictly inside the gap its kept neighbours leave, so
+			// the keys follow the requested order
+			prev := ""
+			for _, id := range tt.ids {
+				key, moved := changed[id]
+				if !moved {
+					key = position[id]
+				}
+				if _, err := ordering.KeyBetween(prev, key); err != nil {
+					t.Fatalf("product id %d at %q does not follow %q: %v", id, key, prev, err)
+				}
+				prev = key
+			}
+
+			for id, key := range changed {
+				if slices.Contains(keys, key) {
+					t.Fatalf("product id %d took the existing key %q", id, key)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateReorder(t *testing.T) {
+	current := []*Product{{ID: 1}, {ID: 2}, {ID: 3}}
+
+	tests := []struct {
+		name    string
+		ids     []uint
+		wantErr bool
+	}{
+		{name: "every product once", ids: []uint{3, 1, 2}},
+		{name: "missing product", ids: []uint{1, 2}, wantErr: true},
+		{name: "extra product", ids: []uint{1, 2, 3, 4}, wantErr: true},
+		{name: "product of another list", ids: []uint{1, 2, 4}, wantErr: true},
+		{name: "duplicate product", ids: []uint{1, 2, 2}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateReorder(current, tt.ids)
+			if tt.wantErr && !apperrors.IsCode(err, apperrors.ErrCodeValidation) {
+				t.Fatalf("got error %v, want a validation error", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("got error %v, want none", err)
+			}
+		})
+	}
+}
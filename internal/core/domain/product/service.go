@@ -2,38 +2,98 @@ package product
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"math/rand/v2"
+	"strings"
+	"time"
 
 	"github.com/zhunismp/intent-products-api/internal/core/domain/cause"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/settings"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
 	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/utils/ordering"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/sharing"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/tag"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/wishlist"
+	"github.com/zhunismp/intent-products-api/internal/core/infrastructure/transaction"
 )
 
+// positionAttempts bounds how often a write that lost a position race is retried
+const positionAttempts = 5
+
 type productService struct {
 	productRepo ProductRepository
+	historyRepo StatusHistoryRepository
 	causeSvc    cause.CauseUsecase
+	tagSvc      tag.TagUsecase
+	listSvc     wishlist.WishlistUsecase
+	settingsSvc settings.SettingsUsecase
+	plans       PlanCleaner
+	policy      sharing.AccessPolicy
+	txManager   transaction.TxManager
 	logger      *slog.Logger
+
+	// a generated position longer than this rebalances the list's positions
+	maxPositionLength int
 }
 
 func NewProductService(
 	productRepo ProductRepository,
+	historyRepo StatusHistoryRepository,
 	causeSvc cause.CauseUsecase,
+	tagSvc tag.TagUsecase,
+	listSvc wishlist.WishlistUsecase,
+	settingsSvc settings.SettingsUsecase,
+	plans PlanCleaner,
+	policy sharing.AccessPolicy,
+	txManager transaction.TxManager,
+	maxPositionLength int,
 	logger *slog.Logger,
 ) ProductUsecase {
 	return &productService{
-		productRepo: productRepo,
-		causeSvc:    causeSvc,
-		logger:      logger,
+		productRepo:       productRepo,
+		historyRepo:       historyRepo,
+		causeSvc:          causeSvc,
+		tagSvc:            tagSvc,
+		listSvc:           listSvc,
+		settingsSvc:       settingsSvc,
+		plans:             plans,
+		policy:            policy,
+		txManager:         txManager,
+		logger:            logger,
+		maxPositionLength: maxPositionLength,
 	}
 }
 
 func (s *productService) CreateProduct(
 	ctx context.Context,
-	ownerID uint,
+	userID uint,
+	listID uint,
 	title string,
 	price float64,
 	link string,
 	reasons []string,
+	coolingOffHours *int,
 ) error {
+	if err := ValidatePrice(price); err != nil {
+		return err
+	}
+	if coolingOffHours != nil {
+		if err := settings.ValidateCoolingOffHours(*coolingOffHours); err != nil {
+			return err
+		}
+	}
+
+	// editors of a shared list add products on behalf of its owner
+	ownerID := userID
+	if listID != 0 {
+		o, err := s.policy.AuthorizeList(ctx, userID, listID, sharing.ActionEdit)
+		if err != nil {
+			return err
+		}
+		ownerID = o
+	}
 
 	product := &Product{
 		OwnerID:  ownerID,
@@ -42,21 +102,41 @@ func (s *productService) CreateProduct(
 		Link:     link,
 		Price:    price,
 		Status:   PENDING,
-	}
 
-	productID, err := s.productRepo.CreateProduct(ctx, product)
-	if err != nil {
-		return err
+		CoolingOffHours: coolingOffHours,
 	}
 
-	if err := s.causeSvc.BulkCreateCauses(ctx, productID, reasons); err != nil {
+	var productID uint
+	err := s.withPositionRetry(ctx, func(ctx context.Context) error {
+		list, err := s.listSvc.ResolveList(ctx, ownerID, listID)
+		if err != nil {
+			return err
+		}
+		product.ListID = list.ID
+
+		id, err := s.productRepo.CreateProduct(ctx, product)
+		if err != nil {
+			return err
+		}
+		productID = id
+
+		if len(reasons) == 0 {
+			return nil
+		}
+		if err := s.causeSvc.BulkCreateCauses(ctx, productID, reasons, "", 0); err != nil {
+			return err
+		}
+		return s.refreshConfidence(ctx, ownerID, productID)
+	})
+	if err != nil {
 		return err
 	}
 
 	s.logger.InfoContext(ctx, "product saved successfully",
-		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Uint64("user_id", uint64(userID)),
 		slog.Group("product_info",
 			slog.Uint64("id", uint64(productID)),
+			slog.Uint64("list_id", uint64(product.ListID)),
 			slog.String("title", title),
 			slog.String("link", link),
 			slog.Float64("price", price),
@@ -67,7 +147,11 @@ func (s *productService) CreateProduct(
 	return nil
 }
 
-func (s *productService) GetProduct(ctx context.Context, ownerID, productID uint) (*Product, error) {
+func (s *productService) GetProduct(ctx context.Context, userID, productID uint) (*Product, error) {
+	ownerID, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionView)
+	if err != nil {
+		return nil, err
+	}
 
 	product, err := s.productRepo.GetProduct(ctx, ownerID, productID)
 	if err != nil {
@@ -81,115 +165,1166 @@ func (s *productService) GetProduct(ctx context.Context, ownerID, productID uint
 
 	product.Causes = causes
 
+	tags, err := s.tagSvc.GetProductTags(ctx, product.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	product.Tags = tags
+
+	if err := s.applyCoolingOff(ctx, ownerID, product); err != nil {
+		return nil, err
+	}
+
 	s.logger.InfoContext(ctx, "get product successfully",
-		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Uint64("user_id", uint64(userID)),
 		slog.Uint64("product_id", uint64(product.ID)),
 	)
 
 	return product, nil
 }
 
-func (s *productService) GetAllProducts(ctx context.Context, ownerID uint, filter *Filter) ([]*Product, error) {
+func (s *productService) GetAllProducts(ctx context.Context, userID uint, filter *Filter) (*ProductPage, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	ownerID, err := s.filterOwner(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
 
 	products, err := s.productRepo.FindAllProducts(ctx, ownerID, filter)
 	if err != nil {
 		return nil, err
 	}
 
+	total, err := s.productRepo.CountProducts(ctx, ownerID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyCoolingOff(ctx, ownerID, products...); err != nil {
+		return nil, err
+	}
+
 	s.logger.InfoContext(ctx, "get all products successfully",
-		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Uint64("user_id", uint64(userID)),
 		slog.Group("filter",
-			slog.String("status", filter.Status),
+			slog.Any("statuses", filter.Statuses),
+			slog.String("sort_by", filter.SortBy),
+			slog.Bool("sort_desc", filter.SortDesc),
 			slog.Int("page", filter.Page),
 			slog.Int("size", filter.Size),
 		),
+		slog.Int64("total", total),
+	)
+
+	return &ProductPage{
+		Items: products,
+		Total: total,
+		Page:  filter.Page,
+		Size:  filter.Size,
+	}, nil
+}
+
+// filterOwner returns the owner whose products the filter reads, the owner of the list when
+// the filter keeps a list shared with the user.
+func (s *productService) filterOwner(ctx context.Context, userID uint, filter *Filter) (uint, error) {
+	if filter.ListID == nil {
+		return userID, nil
+	}
+	return s.policy.AuthorizeList(ctx, userID, *filter.ListID, sharing.ActionView)
+}
+
+func (s *productService) GetSummary(ctx context.Context, ownerID uint) (*ProductSummary, error) {
+	rows, err := s.productRepo.SummarizeByStatus(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := newProductSummary(rows)
+
+	tags, err := s.productRepo.SummarizeByTag(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	summary.Tags = tags
+
+	s.logger.InfoContext(ctx, "get product summary successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Int64("count", summary.Count),
 	)
 
-	return products, nil
+	return summary, nil
 }
 
-func (s *productService) Move(ctx context.Context, ownerID uint, productID uint, productAfterID *uint) error {
-	var prevPos, nextPos string
+func (s *productService) GetProductsPage(ctx context.Context, userID uint, filter *CursorFilter) (*CursorPage, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	if !filter.IsManualOrder() {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "cursor pagination only supports the position order", nil)
+	}
+
+	ownerID, err := s.filterOwner(ctx, userID, &filter.Filter)
+	if err != nil {
+		return nil, err
+	}
 
-	if productAfterID == nil {
-		// moving to first product
-		np, err := s.productRepo.GetFirstPosition(ctx, ownerID)
+	var cursor *Cursor
+	if filter.Cursor != "" {
+		c, err := DecodeCursor(filter.Cursor)
 		if err != nil {
-			// TODO: handle log
-			return err
+			return nil, err
+		}
+		cursor = c
+	}
+
+	// one extra row tells whether there is another page in the requested direction
+	products, err := s.productRepo.FindProductsPage(ctx, ownerID, &filter.Filter, cursor, filter.Size+1)
+	if err != nil {
+		return nil, err
+	}
+
+	backward := cursor != nil && cursor.Direction == DirectionPrev
+	hasMore := len(products) > filter.Size
+	if hasMore {
+		if backward {
+			products = products[1:]
+		} else {
+			products = products[:filter.Size]
+		}
+	}
+
+	if err := s.applyCoolingOff(ctx, ownerID, products...); err != nil {
+		return nil, err
+	}
+
+	page := &CursorPage{Items: products}
+	if len(products) > 0 {
+		first, last := products[0], products[len(products)-1]
+
+		// coming from a cursor means there is a page on the side we came from
+		if (backward && hasMore) || (!backward && cursor != nil) {
+			page.PrevCursor = cursorOf(first, DirectionPrev)
+		}
+		if (!backward && hasMore) || backward {
+			page.NextCursor = cursorOf(last, DirectionNext)
 		}
+	}
+
+	s.logger.InfoContext(ctx, "get products page successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Group("filter",
+			slog.Any("statuses", filter.Statuses),
+			slog.Int("size", filter.Size),
+			slog.Bool("has_cursor", cursor != nil),
+		),
+	)
+
+	return page, nil
+}
 
-		nextPos = np
-	} else {
-		// moving after specific item
-		pp, err := s.productRepo.GetPositionByProductID(ctx, ownerID, *productAfterID)
+func (s *productService) UpdateProduct(ctx context.Context, userID, productID uint, patch *Patch) (*Product, error) {
+	if err := patch.Validate(); err != nil {
+		return nil, err
+	}
+
+	ownerID, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	var product *Product
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		p, err := s.productRepo.GetProduct(ctx, ownerID, productID)
 		if err != nil {
-			// TODO: handle log
 			return err
 		}
-		np, err := s.productRepo.GetNextPosition(ctx, ownerID, pp)
+
+		fromStatus := p.Status
+		statusChanged := patch.Status != nil && *patch.Status != fromStatus
+		if statusChanged {
+			if err := ValidateTransition(fromStatus, *patch.Status); err != nil {
+				return err
+			}
+
+			// the cooling-off in force before the patch decides, overrides go through transitions
+			if err := s.applyCoolingOff(ctx, ownerID, p); err != nil {
+				return err
+			}
+			if err := CheckCoolingOff(p, *patch.Status, nil); err != nil {
+				return err
+			}
+		}
+
+		if !patch.IsEmpty() {
+			patch.ApplyTo(p)
+
+			p, err = s.productRepo.UpdateProduct(ctx, p)
+			if err != nil {
+				return err
+			}
+		}
+
+		if statusChanged {
+			if err := s.leaveStatus(ctx, productID, fromStatus, p.Status); err != nil {
+				return err
+			}
+			if err := s.recordStatusChange(ctx, userID, productID, fromStatus, p.Status); err != nil {
+				return err
+			}
+		}
+
+		product = p
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	causes, err := s.causeSvc.GetCauses(ctx, product.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	product.Causes = causes
+
+	tags, err := s.tagSvc.GetProductTags(ctx, product.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	product.Tags = tags
+
+	if err := s.applyCoolingOff(ctx, ownerID, product); err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "product updated successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Group("product_info",
+			slog.Uint64("id", uint64(product.ID)),
+			slog.String("title", product.Name),
+			slog.String("link", product.Link),
+			slog.Float64("price", product.Price),
+			slog.String("status", product.Status),
+		),
+	)
+
+	return product, nil
+}
+
+func (s *productService) TransitionStatus(ctx context.Context, userID, productID uint, status string, override *Override) (*Product, error) {
+	if err := override.Validate(); err != nil {
+		return nil, err
+	}
+
+	ownerID, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.transition(ctx, userID, ownerID, productID, status, override, false)
+}
+
+func (s *productService) SettleStatus(ctx context.Context, userID, ownerID, productID uint, status string) (*Product, error) {
+	return s.transition(ctx, userID, ownerID, productID, status, nil, true)
+}
+
+// transition moves the product to the status, a settled transition is not held back by the
+// cooling-off and leaves no override behind.
+func (s *productService) transition(
+	ctx context.Context,
+	userID uint,
+	ownerID uint,
+	productID uint,
+	status string,
+	override *Override,
+	settled bool,
+) (*Product, error) {
+	var fromStatus string
+	var overridden bool
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		product, err := s.productRepo.GetProduct(ctx, ownerID, productID)
 		if err != nil {
-			// TODO: handle log
 			return err
 		}
-		prevPos, nextPos = pp, np
+
+		fromStatus = product.Status
+		if err := ValidateTransition(fromStatus, status); err != nil {
+			return err
+		}
+
+		if !settled {
+			if err := s.applyCoolingOff(ctx, ownerID, product); err != nil {
+				return err
+			}
+			if err := CheckCoolingOff(product, status, override); err != nil {
+				return err
+			}
+			overridden = status == BOUGHT && product.CoolingOff.IsActive()
+		}
+
+		if err := s.productRepo.UpdateStatus(ctx, ownerID, productID, fromStatus, status); err != nil {
+			return err
+		}
+		if err := s.leaveStatus(ctx, productID, fromStatus, status); err != nil {
+			return err
+		}
+
+		if err := s.recordStatusChange(ctx, userID, productID, fromStatus, status); err != nil {
+			return err
+		}
+
+		// an override that was not needed leaves no trace
+		if !overridden {
+			return nil
+		}
+		justification := []string{strings.TrimSpace(override.Justification)}
+		if err := s.causeSvc.BulkCreateCauses(ctx, productID, justification, cause.PolarityPro, 0); err != nil {
+			return err
+		}
+		return s.refreshConfidence(ctx, ownerID, productID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	newPos, err := ordering.KeyBetween(prevPos, nextPos)
+	product, err := s.productRepo.GetProduct(ctx, ownerID, productID)
 	if err != nil {
-		// TODO: handle log
-		return err
+		return nil, err
 	}
 
-	if err := s.productRepo.UpdatePosition(ctx, ownerID, productID, newPos); err != nil {
-		// TODO: handle log
-		return err
+	if err := s.applyCoolingOff(ctx, ownerID, product); err != nil {
+		return nil, err
 	}
 
-	s.logger.InfoContext(ctx, "move product successfully",
-		slog.Uint64("user_id", uint64(ownerID)),
+	s.logger.InfoContext(ctx, "product status changed successfully",
+		slog.Uint64("user_id", uint64(userID)),
 		slog.Uint64("product_id", uint64(productID)),
-		slog.Group("position_info",
-			slog.String("new_position", newPos),
-			slog.String("prev_position", prevPos),
-			slog.String("next_position", nextPos),
+		slog.Group("status_info",
+			slog.String("from", fromStatus),
+			slog.String("to", status),
+			slog.Bool("cooling_off_overridden", overridden),
+			slog.Bool("settled", settled),
 		),
 	)
 
+	return product, nil
+}
+
+func (s *productService) GetStatusHistory(ctx context.Context, userID, productID uint) ([]*StatusChange, error) {
+	if _, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionView); err != nil {
+		return nil, err
+	}
+
+	changes, err := s.historyRepo.FindByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "get status history successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Uint64("product_id", uint64(productID)),
+		slog.Int("change_count", len(changes)),
+	)
+
+	return changes, nil
+}
+
+// leaveStatus cleans up what the product's old status kept. A product no longer paid by
+// installment drops its plan, so it can be put on a new one later.
+func (s *productService) leaveStatus(ctx context.Context, productID uint, from, to string) error {
+	if from == INSTALLMENT && to == PENDING {
+		return s.plans.DeletePlansByProductID(ctx, productID)
+	}
 	return nil
 }
 
-func (s *productService) DeleteProduct(ctx context.Context, ownerID, productID uint) error {
+func (s *productService) recordStatusChange(ctx context.Context, changedBy, productID uint, from, to string) error {
+	return s.historyRepo.SaveStatusChange(ctx, &StatusChange{
+		ProductID:  productID,
+		ChangedBy:  changedBy,
+		FromStatus: from,
+		ToStatus:   to,
+	})
+}
 
-	if err := s.productRepo.DeleteProduct(ctx, ownerID, productID); err != nil {
+func (s *productService) Move(ctx context.Context, userID uint, cmd *MoveCommand) error {
+	if err := cmd.Validate(); err != nil {
 		return err
 	}
 
-	if err := s.causeSvc.DeleteCauses(ctx, productID); err != nil {
+	ownerID, err := s.policy.AuthorizeProduct(ctx, userID, cmd.ProductID, sharing.ActionEdit)
+	if err != nil {
 		return err
 	}
 
-	s.logger.InfoContext(ctx, "product deleted successfully",
-		slog.Uint64("user_id", uint64(ownerID)),
-		slog.Uint64("product_id", uint64(productID)),
+	var prevPos, nextPos, newPos string
+	var listID uint
+	rebalanced := false
+
+	err = s.withPositionRetry(ctx, func(ctx context.Context) error {
+		// concurrent moves of the same product queue up here
+		if err := s.productRepo.LockProduct(ctx, ownerID, cmd.ProductID); err != nil {
+			return err
+		}
+
+		l, pp, np, err := s.moveNeighbours(ctx, userID, ownerID, cmd)
+		if err != nil {
+			return err
+		}
+		listID, prevPos, nextPos = l, pp, np
+
+		pos, err := ordering.JitteredKeyBetween(prevPos, nextPos, PositionSpread)
+		if err != nil {
+			// TODO: handle log
+			return err
+		}
+		newPos = pos
+
+		if err := s.productRepo.UpdatePosition(ctx, ownerID, cmd.ProductID, listID, newPos); err != nil {
+			// TODO: handle log
+			return err
+		}
+
+		// keys grow when items keep landing between the same neighbours, spread them out
+		// again before they reach the column limit
+		if s.maxPositionLength > 0 && len(newPos) > s.maxPositionLength {
+			if _, err := s.rebalance(ctx, listID); err != nil {
+				return err
+			}
+			rebalanced = true
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "move product successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Uint64("product_id", uint64(cmd.ProductID)),
+		slog.Group("position_info",
+			slog.String("mode", cmd.Mode),
+			slog.Uint64("list_id", uint64(listID)),
+			slog.String("new_position", newPos),
+			slog.String("prev_position", prevPos),
+			slog.String("next_position", nextPos),
+			slog.Bool("rebalanced", rebalanced),
+		),
 	)
 
 	return nil
 }
 
-func (s *productService) AddCauses(ctx context.Context, ownerID, productID uint, reasons []string) error {
-	if err := s.productRepo.ValidateOwnership(ctx, ownerID, productID); err != nil {
-		return err
+// moveNeighbours finds the list the moved product lands in and the positions it lands
+// between, leaving the product itself out so its current key never bounds the new one. The
+// target of a relative move is looked up under the owner, a product of another owner is
+// reported as not found. Moving into another list needs the user to be able to edit it too.
+func (s *productService) moveNeighbours(ctx context.Context, userID, ownerID uint, cmd *MoveCommand) (uint, string, string, error) {
+	repo, self := s.productRepo, cmd.ProductID
+
+	product, err := repo.GetProduct(ctx, ownerID, self)
+	if err != nil {
+		return 0, "", "", err
 	}
+	listID := product.ListID
 
-	s.logger.InfoContext(ctx, "user have permission for product",
-		slog.Uint64("user_id", uint64(ownerID)),
-		slog.Uint64("product_id", uint64(productID)),
-	)
+	if cmd.Mode == MoveBefore || cmd.Mode == MoveAfter {
+		target, err := repo.GetProduct(ctx, ownerID, *cmd.TargetID)
+		if err != nil {
+			return 0, "", "", err
+		}
 
-	if err := s.causeSvc.BulkCreateCauses(ctx, productID, reasons); err != nil {
-		return err
+		// landing next to a product of another list moves the product into that list
+		if target.ListID != listID {
+			if _, err := s.policy.AuthorizeList(ctx, userID, target.ListID, sharing.ActionEdit); err != nil {
+				return 0, "", "", err
+			}
+			if _, err := s.listSvc.ResolveList(ctx, ownerID, target.ListID); err != nil {
+				return 0, "", "", err
+			}
+			listID = target.ListID
+		}
+
+		if cmd.Mode == MoveBefore {
+			prev, err := repo.GetPrevPosition(ctx, listID, self, target.Position)
+			return listID, prev, target.Position, err
+		}
+		next, err := repo.GetNextPosition(ctx, listID, self, target.Position)
+		return listID, target.Position, next, err
 	}
 
+	if cmd.ListID != nil && *cmd.ListID != listID {
+		if _, err := s.policy.AuthorizeList(ctx, userID, *cmd.ListID, sharing.ActionEdit); err != nil {
+			return 0, "", "", err
+		}
+		list, err := s.listSvc.ResolveList(ctx, ownerID, *cmd.ListID)
+		if err != nil {
+			return 0, "", "", err
+		}
+		listID = list.ID
+	}
+
+	switch cmd.Mode {
+	case MoveTop:
+		next, err := repo.GetFirstPosition(ctx, listID, self)
+		return listID, "", next, err
+	case MoveBottom:
+		prev, err := repo.GetLastPosition(ctx, listID, self)
+		return listID, prev, "", err
+	default:
+		index := *cmd.Index
+		if index == 0 {
+			next, err := repo.GetFirstPosition(ctx, listID, self)
+			return listID, "", next, err
+		}
+
+		prev, err := repo.GetPositionAt(ctx, listID, self, index-1)
+		if err != nil {
+			return 0, "", "", err
+		}
+		if prev == "" {
+			// past the end of the list
+			prev, err = repo.GetLastPosition(ctx, listID, self)
+			return listID, prev, "", err
+		}
+		next, err := repo.GetNextPosition(ctx, listID, self, prev)
+		return listID, prev, next, err
+	}
+}
+
+func (s *productService) DeleteProduct(ctx context.Context, userID, productID uint) error {
+	ownerID, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionEdit)
+	if err != nil {
+		return err
+	}
+
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.productRepo.DeleteProduct(ctx, ownerID, productID); err != nil {
+			return err
+		}
+
+		if err := s.causeSvc.DeleteCauses(ctx, productID); err != nil {
+			return err
+		}
+
+		if err := s.plans.DeletePlansByProductID(ctx, productID); err != nil {
+			return err
+		}
+
+		return s.tagSvc.ClearProductTags(ctx, productID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "product deleted successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Uint64("product_id", uint64(productID)),
+	)
+
+	return nil
+}
+
+func (s *productService) ExportWishlists(ctx context.Context, ownerID uint) ([]*ListExport, error) {
+	lists, err := s.listSvc.ListWishlists(ctx, ownerID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := s.productRepo.FindAllByOwner(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	exports := make([]*ListExport, 0, len(lists))
+	byList := make(map[uint]*ListExport, len(lists))
+	for _, l := range lists {
+		export := &ListExport{List: l, Products: make([]*Product, 0)}
+		exports = append(exports, export)
+		byList[l.ID] = export
+	}
+
+	for _, p := range products {
+		export, ok := byList[p.ListID]
+		if !ok {
+			return nil, apperrors.New(
+				apperrors.ErrCodeInternal,
+				fmt.Sprintf("product id %d is in unknown wishlist id %d", p.ID, p.ListID),
+				nil,
+			)
+		}
+
+		causes, err := s.causeSvc.GetCauses(ctx, p.ID)
+		if err != nil {
+			return nil, err
+		}
+		p.Causes = causes
+
+		tags, err := s.tagSvc.GetProductTags(ctx, p.ID)
+		if err != nil {
+			return nil, err
+		}
+		p.Tags = tags
+
+		export.Products = append(export.Products, p)
+	}
+
+	s.logger.InfoContext(ctx, "exported wishlists successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Int("list_count", len(exports)),
+		slog.Int("product_count", len(products)),
+	)
+
+	return exports, nil
+}
+
+func (s *productService) ImportWishlists(ctx context.Context, ownerID uint, lists []*ListExport) (int, error) {
+	if err := validateImport(lists); err != nil {
+		return 0, err
+	}
+
+	// all or nothing, a half imported wishlist is worse than none
+	var imported int
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		imported = 0
+
+		existing, err := s.listSvc.ListWishlists(ctx, ownerID, true)
+		if err != nil {
+			return err
+		}
+		listsByName := make(map[string]*wishlist.Wishlist, len(existing))
+		for _, l := range existing {
+			listsByName[strings.ToLower(l.Name)] = l
+		}
+
+		tags, err := s.tagSvc.ListTags(ctx, ownerID)
+		if err != nil {
+			return err
+		}
+		tagsByName := make(map[string]uint, len(tags))
+		for _, t := range tags {
+			tagsByName[strings.ToLower(t.Name)] = t.ID
+		}
+
+		for _, l := range lists {
+			list, err := s.importList(ctx, ownerID, l.List, listsByName)
+			if err != nil {
+				return err
+			}
+
+			for _, p := range l.Products {
+				id, err := s.productRepo.CreateProduct(ctx, &Product{
+					OwnerID:  ownerID,
+					ListID:   list.ID,
+					Name:     p.Name,
+					ImageUrl: p.ImageUrl,
+					Link:     p.Link,
+					Price:    p.Price,
+					Status:   p.Status,
+
+					CoolingOffHours: p.CoolingOffHours,
+				})
+				if err != nil {
+					return err
+				}
+
+				if err := s.causeSvc.RestoreCauses(ctx, id, p.Causes); err != nil {
+					return err
+				}
+				if err := s.importTags(ctx, ownerID, id, p.Tags, tagsByName); err != nil {
+					return err
+				}
+				if err := s.refreshConfidence(ctx, ownerID, id); err != nil {
+					return err
+				}
+				imported++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	s.logger.InfoContext(ctx, "imported wishlists successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Int("list_count", len(lists)),
+		slog.Int("product_count", imported),
+	)
+
+	return imported, nil
+}
+
+// importList returns the list imported products of l go to. The default list maps onto
+// the owner's default list and a list named like one the owner has already is merged into
+// it, keeping its look. Any other list is created as exported.
+func (s *productService) importList(ctx context.Context, ownerID uint, l *wishlist.Wishlist, byName map[string]*wishlist.Wishlist) (*wishlist.Wishlist, error) {
+	if l.IsDefault {
+		return s.listSvc.ResolveList(ctx, ownerID, 0)
+	}
+	if list, ok := byName[strings.ToLower(l.Name)]; ok {
+		return list, nil
+	}
+
+	list, err := s.listSvc.CreateWishlist(ctx, ownerID, l.Name, l.Emoji, l.Color)
+	if err != nil {
+		return nil, err
+	}
+	if l.Archived {
+		archived := true
+		list, err = s.listSvc.UpdateWishlist(ctx, ownerID, list.ID, &wishlist.WishlistPatch{Archived: &archived})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	byName[strings.ToLower(list.Name)] = list
+	return list, nil
+}
+
+// importTags links the product to the owner's tags named like tags, creating the missing ones.
+func (s *productService) importTags(ctx context.Context, ownerID, productID uint, tags []*tag.Tag, byName map[string]uint) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	tagIDs := make([]uint, 0, len(tags))
+	for _, t := range tags {
+		id, ok := byName[strings.ToLower(t.Name)]
+		if !ok {
+			created, err := s.tagSvc.CreateTag(ctx, ownerID, t.Name)
+			if err != nil {
+				return err
+			}
+			id = created.ID
+			byName[strings.ToLower(created.Name)] = id
+		}
+		tagIDs = append(tagIDs, id)
+	}
+
+	return s.tagSvc.TagProduct(ctx, ownerID, productID, tagIDs)
+}
+
+func (s *productService) Reorder(ctx context.Context, userID, listID uint, productIDs []uint) (int, error) {
+	ownerID := userID
+	if listID != 0 {
+		o, err := s.policy.AuthorizeList(ctx, userID, listID, sharing.ActionEdit)
+		if err != nil {
+			return 0, err
+		}
+		ownerID = o
+	}
+
+	var moved int
+	rebalanced := false
+
+	err := s.withPositionRetry(ctx, func(ctx context.Context) error {
+		// listID 0 resolves to the owner's default list, any other list is looked up as is.
+		// Archived lists can be reordered too, archiving only keeps a list out of listings
+		// and new products out of it.
+		var list *wishlist.Wishlist
+		var err error
+		if listID == 0 {
+			list, err = s.listSvc.ResolveList(ctx, ownerID, 0)
+		} else {
+			list, err = s.listSvc.GetWishlist(ctx, ownerID, listID)
+		}
+		if err != nil {
+			return err
+		}
+		listID = list.ID
+
+		// moves wait until the new order is written
+		if err := s.productRepo.LockPositions(ctx, listID); err != nil {
+			return err
+		}
+
+		current, err := s.productRepo.FindAllByList(ctx, listID)
+		if err != nil {
+			return err
+		}
+		if err := ValidateReorder(current, productIDs); err != nil {
+			return err
+		}
+
+		changed, err := reorderedPositions(current, productIDs)
+		if err != nil {
+			return err
+		}
+
+		if err := s.productRepo.ReplacePositions(ctx, listID, changed); err != nil {
+			return err
+		}
+		moved = len(changed)
+
+		// a long run squeezed between two kept products can still produce long keys
+		for _, pos := range changed {
+			if s.maxPositionLength > 0 && len(pos) > s.maxPositionLength {
+				if _, err := s.rebalance(ctx, listID); err != nil {
+					return err
+				}
+				rebalanced = true
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	s.logger.InfoContext(ctx, "reordered products successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Group("position_info",
+			slog.Uint64("list_id", uint64(listID)),
+			slog.Int("product_count", len(productIDs)),
+			slog.Int("moved_count", moved),
+			slog.Bool("rebalanced", rebalanced),
+		),
+	)
+
+	return moved, nil
+}
+
+// reorderedPositions returns the new key of every product that has to move for the products
+// to follow ids. The longest run already in order keeps its keys, the products between two
+// kept ones get fresh keys spread across that gap.
+func reorderedPositions(current []*Product, ids []uint) (map[uint]string, error) {
+	rank := make(map[uint]int, len(current))
+	position := make(map[uint]string, len(current))
+	for i, p := range current {
+		rank[p.ID] = i
+		position[p.ID] = p.Position
+	}
+
+	seq := make([]int, len(ids))
+	for i, id := range ids {
+		seq[i] = rank[id]
+	}
+	kept := keptInOrder(seq)
+
+	changed := make(map[uint]string)
+	for i := 0; i < len(ids); {
+		if kept[i] {
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(ids) && !kept[end] {
+			end++
+		}
+
+		var prev, next string
+		if i > 0 {
+			prev = position[ids[i-1]]
+		}
+		if end < len(ids) {
+			next = position[ids[end]]
+		}
+
+		keys, err := ordering.NKeysBetween(prev, next, uint(end-i))
+		if err != nil {
+			return nil, apperrors.New(apperrors.ErrCodeInternal, "failed to generate positions", err)
+		}
+		for k, id := range ids[i:end] {
+			changed[id] = keys[k]
+		}
+
+		i = end
+	}
+
+	return changed, nil
+}
+
+func (s *productService) RebalancePositions(ctx context.Context, ownerID uint) (int, error) {
+	var moved int
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		lists, err := s.listSvc.ListWishlists(ctx, ownerID, true)
+		if err != nil {
+			return err
+		}
+
+		for _, list := range lists {
+			m, err := s.rebalance(ctx, list.ID)
+			if err != nil {
+				return err
+			}
+			moved += m
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	s.logger.InfoContext(ctx, "rebalanced positions successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Int("moved_count", moved),
+	)
+
+	return moved, nil
+}
+
+// rebalance rewrites the list's positions into evenly spaced short keys in the current
+// order and returns how many products got a new key. It has to run inside a transaction
+// so the order never shows up half rewritten.
+func (s *productService) rebalance(ctx context.Context, listID uint) (int, error) {
+	// moves wait until the whole order is rewritten
+	if err := s.productRepo.LockPositions(ctx, listID); err != nil {
+		return 0, err
+	}
+
+	products, err := s.productRepo.FindAllByList(ctx, listID)
+	if err != nil {
+		return 0, err
+	}
+	if len(products) == 0 {
+		return 0, nil
+	}
+
+	keys, err := ordering.NKeysBetween("", "", uint(len(products)))
+	if err != nil {
+		return 0, apperrors.New(apperrors.ErrCodeInternal, "failed to generate positions", err)
+	}
+
+	changed := make(map[uint]string)
+	for i, p := range products {
+		if p.Position != keys[i] {
+			changed[p.ID] = keys[i]
+		}
+	}
+
+	if err := s.productRepo.ReplacePositions(ctx, listID, changed); err != nil {
+		return 0, err
+	}
+
+	return len(changed), nil
+}
+
+// withPositionRetry runs fn in a transaction and runs it again when it lost a race for a
+// position. Every attempt reads the neighbours afresh and picks a new jittered key.
+func (s *productService) withPositionRetry(ctx context.Context, fn func(ctx context.Context) error) error {
+	var err error
+	for attempt := 1; attempt <= positionAttempts; attempt++ {
+		err = s.txManager.WithinTx(ctx, fn)
+		if !errors.Is(err, ErrPositionTaken) {
+			return err
+		}
+
+		s.logger.WarnContext(ctx, "position taken by a concurrent write, retrying",
+			slog.Int("attempt", attempt),
+		)
+
+		// a random pause so the racers do not meet again on the next attempt
+		backoff := time.Duration(1+rand.IntN(10*attempt)) * time.Millisecond
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+
+	return err
+}
+
+func (s *productService) AddCauses(ctx context.Context, userID, productID uint, reasons []string, polarity string, weight int) error {
+	return s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		ownerID, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionEdit)
+		if err != nil {
+			return err
+		}
+
+		s.logger.InfoContext(ctx, "user have permission for product",
+			slog.Uint64("user_id", uint64(userID)),
+			slog.Uint64("product_id", uint64(productID)),
+		)
+
+		if err := s.causeSvc.BulkCreateCauses(ctx, productID, reasons, polarity, weight); err != nil {
+			return err
+		}
+		return s.refreshConfidence(ctx, ownerID, productID)
+	})
+}
+
+func (s *productService) UpdateCause(ctx context.Context, userID, productID, causeID uint, patch *cause.CausePatch) (*cause.Cause, error) {
+	var c *cause.Cause
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		ownerID, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionEdit)
+		if err != nil {
+			return err
+		}
+
+		updated, err := s.causeSvc.UpdateCause(ctx, productID, causeID, patch)
+		if err != nil {
+			return err
+		}
+		c = updated
+
+		if !patch.ChangesScore() {
+			return nil
+		}
+		return s.refreshConfidence(ctx, ownerID, productID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "updated product cause successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Uint64("product_id", uint64(productID)),
+		slog.Uint64("cause_id", uint64(causeID)),
+	)
+
+	return c, nil
+}
+
+func (s *productService) DeleteCause(ctx context.Context, userID, productID, causeID uint) error {
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		ownerID, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionEdit)
+		if err != nil {
+			return err
+		}
+
+		if err := s.causeSvc.DeleteCause(ctx, productID, causeID); err != nil {
+			return err
+		}
+		return s.refreshConfidence(ctx, ownerID, productID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "deleted product cause successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Uint64("product_id", uint64(productID)),
+		slog.Uint64("cause_id", uint64(causeID)),
+	)
+
+	return nil
+}
+
+// applyCoolingOff works out the wait of products of the owner that are not bought yet,
+// products without a cooling-off of their own follow the owner's default.
+func (s *productService) applyCoolingOff(ctx context.Context, ownerID uint, products ...*Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	defaults, err := s.settingsSvc.GetSettings(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, p := range products {
+		p.CoolingOff = nil
+		if p.Status == BOUGHT {
+			continue
+		}
+
+		hours := defaults.CoolingOffHours
+		if p.CoolingOffHours != nil {
+			hours = *p.CoolingOffHours
+		}
+		p.CoolingOff = NewCoolingOff(p.CreatedAt, hours, now)
+	}
+
+	return nil
+}
+
+// refreshConfidence scores the product again after its causes changed, callers run it in the
+// transaction that changed them. The product row is locked first so concurrent cause changes
+// score one after the other and the last one sees every change.
+func (s *productService) refreshConfidence(ctx context.Context, ownerID, productID uint) error {
+	if err := s.productRepo.LockProduct(ctx, ownerID, productID); err != nil {
+		return err
+	}
+
+	causes, err := s.causeSvc.GetCauses(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	return s.productRepo.UpdateConfidence(ctx, ownerID, productID, ConfidenceScore(causes))
+}
+
+func (s *productService) ReorderCauses(ctx context.Context, userID, productID uint, causeIDs []uint) ([]*cause.Cause, error) {
+	var causes []*cause.Cause
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		ownerID, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionEdit)
+		if err != nil {
+			return err
+		}
+
+		// concurrent reorders of the same product queue up here
+		if err := s.productRepo.LockProduct(ctx, ownerID, productID); err != nil {
+			return err
+		}
+
+		reordered, err := s.causeSvc.ReorderCauses(ctx, productID, causeIDs)
+		causes = reordered
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "reordered product causes successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Uint64("product_id", uint64(productID)),
+		slog.Int("cause_count", len(causes)),
+	)
+
+	return causes, nil
+}
+
+func (s *productService) TagProduct(ctx context.Context, userID, productID uint, tagIDs []uint) ([]*tag.Tag, error) {
+	ownerID, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	// the product's tags come from its owner, whoever edits it
+	if err := s.tagSvc.TagProduct(ctx, ownerID, productID, tagIDs); err != nil {
+		return nil, err
+	}
+
+	tags, err := s.tagSvc.GetProductTags(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "tagged product successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Uint64("product_id", uint64(productID)),
+		slog.Any("tag_ids", tagIDs),
+	)
+
+	return tags, nil
+}
+
+func (s *productService) UntagProduct(ctx context.Context, userID, productID, tagID uint) error {
+	if _, err := s.policy.AuthorizeProduct(ctx, userID, productID, sharing.ActionEdit); err != nil {
+		return err
+	}
+
+	if err := s.tagSvc.UntagProduct(ctx, productID, tagID); err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "untagged product successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Uint64("product_id", uint64(productID)),
+		slog.Uint64("tag_id", uint64(tagID)),
+	)
+
 	return nil
 }
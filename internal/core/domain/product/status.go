@@ -0,0 +1,54 @@
+package product
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+// transitions lists the statuses a product may move to from each status.
+var transitions = map[string][]string{
+	PENDING:     {INSTALLMENT, BOUGHT},
+	INSTALLMENT: {PENDING, BOUGHT},
+	BOUGHT:      {},
+}
+
+type StatusChange struct {
+	ID         uint      `json:"id"`
+	ProductID  uint      `json:"productId"`
+	ChangedBy  uint      `json:"changedBy"`
+	FromStatus string    `json:"fromStatus"`
+	ToStatus   string    `json:"toStatus"`
+	ChangedAt  time.Time `json:"changedAt"`
+}
+
+func IsValidStatus(status string) bool {
+	_, ok := transitions[status]
+	return ok
+}
+
+func AllowedTransitions(from string) []string {
+	return slices.Clone(transitions[from])
+}
+
+func CanTransition(from, to string) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+func ValidateTransition(from, to string) error {
+	if !IsValidStatus(to) {
+		return apperrors.New(apperrors.ErrCodeValidation, fmt.Sprintf("status '%s' is invalid", to), nil)
+	}
+
+	if !CanTransition(from, to) {
+		return apperrors.New(
+			apperrors.ErrCodeConflict,
+			fmt.Sprintf("product can not move from '%s' to '%s'", from, to),
+			nil,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package product
+
+// ProductPage is one offset page of products with the size of the whole listing.
+type ProductPage struct {
+	Items []*Product `json:"items"`
+	Total int64      `json:"total"`
+	Page  int        `json:"page"`
+	Size  int        `json:"size"`
+}
+
+type StatusSummary struct {
+	Status     string  `json:"status"`
+	Count      int64   `json:"count"`
+	TotalPrice float64 `json:"totalPrice"`
+}
+
+// TagSummary counts the products carrying a tag, a product with several tags counts for each.
+type TagSummary struct {
+	TagID      uint    `json:"tagId"`
+	Name       string  `json:"name"`
+	Count      int64   `json:"count"`
+	TotalPrice float64 `json:"totalPrice"`
+}
+
+// ProductSummary aggregates the products of an owner, every status and tag is present even without products.
+type ProductSummary struct {
+	Statuses   []*StatusSummary `json:"statuses"`
+	Tags       []*TagSummary    `json:"tags"`
+	Count      int64            `json:"count"`
+	TotalPrice float64          `json:"totalPrice"`
+}
+
+// newProductSummary fills in the statuses missing from rows and adds up the totals.
+func newProductSummary(rows []*StatusSummary) *ProductSummary {
+	byStatus := make(map[string]*StatusSummary, len(rows))
+	for _, row := range rows {
+		byStatus[row.Status] = row
+	}
+
+	summary := &ProductSummary{Statuses: make([]*StatusSummary, 0, 3)}
+	for _, status := range []string{PENDING, INSTALLMENT, BOUGHT} {
+		row, ok := byStatus[status]
+		if !ok {
+			row = &StatusSummary{Status: status}
+		}
+
+		summary.Statuses = append(summary.Statuses, row)
+		summary.Count += row.Count
+		summary.TotalPrice += row.TotalPrice
+	}
+
+	return summary
+}
@@ -0,0 +1,82 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/product"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+
+	// maxSearchTerms keeps a pasted paragraph from turning into a huge query
+	maxSearchTerms = 16
+)
+
+const (
+	FieldName   string = "name"
+	FieldLink   string = "link"
+	FieldReason string = "reason"
+)
+
+// HighlightStart and HighlightStop surround the matched words of a snippet. The rest of
+// a snippet is HTML escaped, so it can be rendered as is.
+const (
+	HighlightStart = "<mark>"
+	HighlightStop  = "</mark>"
+)
+
+// SearchQuery is the parsed form of what the user typed. Every term has to match
+// and each one matches words starting with it, so results narrow while typing.
+type SearchQuery struct {
+	Text  string
+	Terms []string
+	Limit int
+}
+
+// SearchHighlight is a snippet of a field where the query matched.
+type SearchHighlight struct {
+	Field   string `json:"field"`
+	Snippet string `json:"snippet"`
+}
+
+type SearchResult struct {
+	Product    *product.Product   `json:"product"`
+	Rank       float64            `json:"rank"`
+	Highlights []*SearchHighlight `json:"highlights"`
+}
+
+// ParseSearchQuery splits text into lower case terms of letters and digits, everything
+// else separates terms. A zero limit falls back to the default.
+func ParseSearchQuery(text string, limit int) (*SearchQuery, error) {
+	if limit < 0 || limit > MaxSearchLimit {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "limit is out of range", nil)
+	}
+	if limit == 0 {
+		limit = DefaultSearchLimit
+	}
+
+	terms := Tokenize(text)
+	if len(terms) == 0 {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "search query has no words", nil)
+	}
+	if len(terms) > maxSearchTerms {
+		terms = terms[:maxSearchTerms]
+	}
+
+	return &SearchQuery{
+		Text:  strings.TrimSpace(text),
+		Terms: terms,
+		Limit: limit,
+	}, nil
+}
+
+// Tokenize lower cases text and splits it into words of letters and digits.
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
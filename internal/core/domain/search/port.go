@@ -0,0 +1,15 @@
+package search
+
+import (
+	"context"
+)
+
+type SearchUsecase interface {
+	SearchProducts(ctx context.Context, ownerID uint, text string, limit int) ([]*SearchResult, error)
+}
+
+type SearchRepository interface {
+	// SearchProducts returns the products of the owner matching every term of the query,
+	// best match first, with highlights for the fields that matched.
+	SearchProducts(ctx context.Context, ownerID uint, query *SearchQuery) ([]*SearchResult, error)
+}
@@ -0,0 +1,38 @@
+package search
+
+import (
+	"context"
+	"log/slog"
+)
+
+type searchService struct {
+	searchRepo SearchRepository
+	logger     *slog.Logger
+}
+
+func NewSearchService(searchRepo SearchRepository, logger *slog.Logger) SearchUsecase {
+	return &searchService{searchRepo: searchRepo, logger: logger}
+}
+
+func (s *searchService) SearchProducts(ctx context.Context, ownerID uint, text string, limit int) ([]*SearchResult, error) {
+	query, err := ParseSearchQuery(text, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.searchRepo.SearchProducts(ctx, ownerID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "search products successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Group("search_info",
+			slog.Any("terms", query.Terms),
+			slog.Int("limit", query.Limit),
+			slog.Int("result_count", len(results)),
+		),
+	)
+
+	return results, nil
+}
@@ -0,0 +1,164 @@
+package search_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	searchrepo "github.com/zhunismp/intent-products-api/internal/adapters/secondary/repositories/search"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/cause"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/product"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/search"
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+const ownerID = 1
+
+// newSearchTestService indexes a small wishlist where "espresso" shows up once in each
+// field, plus a product of another owner that matches everything.
+func newSearchTestService() search.SearchUsecase {
+	repo := searchrepo.NewMemorySearchRepository()
+	for _, p := range []*product.Product{
+		{ID: 1, OwnerID: ownerID, Name: "Kettle", Link: "https://shop.example/espresso-kettle"},
+		{ID: 2, OwnerID: ownerID, Name: "Grinder", Causes: []*cause.Cause{
+			{Reason: "fresh beans for espresso every morning"},
+			{Reason: "the old one is loud"},
+		}},
+		{ID: 3, OwnerID: ownerID, Name: "Espresso machine", Link: "https://shop.example/machine"},
+		{ID: 4, OwnerID: ownerID, Name: "Tea <b>cups</b> & saucers"},
+		{ID: 5, OwnerID: 2, Name: "Espresso grinder for mornings"},
+	} {
+		repo.Index(p)
+	}
+	return search.NewSearchService(repo, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestSearchProductsRanking(t *testing.T) {
+	svc := newSearchTestService()
+
+	tests := []struct {
+		name string
+		text string
+		want []uint
+	}{
+		{name: "name outranks reason outranks link", text: "espresso", want: []uint{3, 2, 1}},
+		{name: "terms match word prefixes", text: "ESP", want: []uint{3, 2, 1}},
+		{name: "every term has to match", text: "espresso machine", want: []uint{3}},
+		{name: "terms may match across fields", text: "grinder morning", want: []uint{2}},
+		{name: "no match", text: "toaster", want: []uint{}},
+		{name: "other owners are left out", text: "espresso grinder mornings", want: []uint{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := svc.SearchProducts(context.Background(), ownerID, tt.text, 0)
+			if err != nil {
+				t.Fatalf("search failed: %v", err)
+			}
+
+			got := make([]uint, len(results))
+			for i, r := range results {
+				got[i] = r.Product.ID
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("got products %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchProductsHighlights(t *testing.T) {
+	svc := newSearchTestService()
+
+	tests := []struct {
+		name      string
+		text      string
+		productID uint
+		want      map[string]string
+	}{
+		{
+			name:      "only matched fields are highlighted",
+			text:      "espresso",
+			productID: 3,
+			want:      map[string]string{search.FieldName: "<mark>Espresso</mark> machine"},
+		},
+		{
+			name:      "only matched reasons make the snippet",
+			text:      "grinder fresh",
+			productID: 2,
+			want: map[string]string{
+				search.FieldName:   "<mark>Grinder</mark>",
+				search.FieldReason: "<mark>fresh</mark> beans for espresso every morning",
+			},
+		},
+		{
+			name:      "link",
+			text:      "kettle",
+			productID: 1,
+			want: map[string]string{
+				search.FieldName: "<mark>Kettle</mark>",
+				search.FieldLink: "https://shop.example/espresso-<mark>kettle</mark>",
+			},
+		},
+		{
+			name:      "the text is escaped",
+			text:      "cups",
+			productID: 4,
+			want:      map[string]string{search.FieldName: "Tea &lt;b&gt;<mark>cups</mark>&lt;/b&gt; &amp; saucers"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := svc.SearchProducts(context.Background(), ownerID, tt.text, 0)
+			if err != nil {
+				t.Fatalf("search failed: %v", err)
+			}
+
+			idx := slices.IndexFunc(results, func(r *search.SearchResult) bool { return r.Product.ID == tt.productID })
+			if idx < 0 {
+				t.Fatalf("product id %d not found", tt.productID)
+			}
+
+			got := make(map[string]string)
+			for _, h := range results[idx].Highlights {
+				got[h.Field] = h.Snippet
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got highlights %v, want %v", got, tt.want)
+			}
+			for field, snippet := range tt.want {
+				if got[field] != snippet {
+					t.Errorf("got %s snippet %q, want %q", field, got[field], snippet)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchProductsInvalidQuery(t *testing.T) {
+	svc := newSearchTestService()
+
+	tests := []struct {
+		name  string
+		text  string
+		limit int
+	}{
+		{name: "empty", text: ""},
+		{name: "blank", text: "   "},
+		{name: "no words", text: "&& !!"},
+		{name: "negative limit", text: "espresso", limit: -1},
+		{name: "limit above max", text: "espresso", limit: search.MaxSearchLimit + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.SearchProducts(context.Background(), ownerID, tt.text, tt.limit)
+			if !apperrors.IsCode(err, apperrors.ErrCodeValidation) {
+				t.Fatalf("got error %v, want a validation error", err)
+			}
+		})
+	}
+}
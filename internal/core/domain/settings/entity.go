@@ -0,0 +1,59 @@
+package settings
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+// MaxCoolingOffHours caps a cooling-off at a year
+const MaxCoolingOffHours = 8760
+
+// Settings are the owner wide defaults, an owner who never changed them has the zero value.
+type Settings struct {
+	OwnerID uint `json:"ownerId"`
+
+	// CoolingOffHours is how long a product waits after it was added before it can be marked
+	// bought, products may set their own. 0 turns the wait off.
+	CoolingOffHours int `json:"coolingOffHours"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ValidateCoolingOffHours checks hours is a usable cooling-off, 0 included.
+func ValidateCoolingOffHours(hours int) error {
+	if hours < 0 || hours > MaxCoolingOffHours {
+		return apperrors.New(
+			apperrors.ErrCodeValidation,
+			fmt.Sprintf("cooling-off must be between 0 and %d hours", MaxCoolingOffHours),
+			nil,
+		)
+	}
+	return nil
+}
+
+// SettingsPatch describes a partial update of the settings. Nil fields are left untouched.
+type SettingsPatch struct {
+	CoolingOffHours *int
+}
+
+func (p *SettingsPatch) IsEmpty() bool {
+	return p == nil || p.CoolingOffHours == nil
+}
+
+func (p *SettingsPatch) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.CoolingOffHours != nil {
+		return ValidateCoolingOffHours(*p.CoolingOffHours)
+	}
+	return nil
+}
+
+func (p *SettingsPatch) ApplyTo(s *Settings) {
+	if p.CoolingOffHours != nil {
+		s.CoolingOffHours = *p.CoolingOffHours
+	}
+}
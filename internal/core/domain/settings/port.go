@@ -0,0 +1,16 @@
+package settings
+
+import "context"
+
+type SettingsUsecase interface {
+	// GetSettings returns the owner's settings, the defaults when the owner never saved any.
+	GetSettings(ctx context.Context, ownerID uint) (*Settings, error)
+	UpdateSettings(ctx context.Context, ownerID uint, patch *SettingsPatch) (*Settings, error)
+}
+
+type SettingsRepository interface {
+	// FindByOwner returns nil without an error when the owner never saved settings.
+	FindByOwner(ctx context.Context, ownerID uint) (*Settings, error)
+	// SaveSettings creates or replaces the owner's settings.
+	SaveSettings(ctx context.Context, settings *Settings) (*Settings, error)
+}
@@ -0,0 +1,70 @@
+package settings
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/zhunismp/intent-products-api/internal/core/infrastructure/transaction"
+)
+
+type settingsService struct {
+	settingsRepo SettingsRepository
+	txManager    transaction.TxManager
+	logger       *slog.Logger
+}
+
+func NewSettingsService(settingsRepo SettingsRepository, txManager transaction.TxManager, logger *slog.Logger) SettingsUsecase {
+	return &settingsService{
+		settingsRepo: settingsRepo,
+		txManager:    txManager,
+		logger:       logger,
+	}
+}
+
+func (s *settingsService) GetSettings(ctx context.Context, ownerID uint) (*Settings, error) {
+	settings, err := s.settingsRepo.FindByOwner(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		settings = &Settings{OwnerID: ownerID}
+	}
+
+	return settings, nil
+}
+
+func (s *settingsService) UpdateSettings(ctx context.Context, ownerID uint, patch *SettingsPatch) (*Settings, error) {
+	if err := patch.Validate(); err != nil {
+		return nil, err
+	}
+
+	var settings *Settings
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		current, err := s.GetSettings(ctx, ownerID)
+		if err != nil {
+			return err
+		}
+
+		if patch.IsEmpty() {
+			settings = current
+			return nil
+		}
+
+		patch.ApplyTo(current)
+
+		settings, err = s.settingsRepo.SaveSettings(ctx, current)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "updated settings successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Group("settings_info",
+			slog.Int("cooling_off_hours", settings.CoolingOffHours),
+		),
+	)
+
+	return settings, nil
+}
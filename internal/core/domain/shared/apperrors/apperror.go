@@ -1,6 +1,9 @@
 package apperrors
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 type AppError struct {
 	Code    string `json:"code"`
@@ -26,3 +29,9 @@ func New(code, message string, err error) *AppError {
 		Err:     err,
 	}
 }
+
+// IsCode reports whether err is an AppError with the given code.
+func IsCode(err error, code string) bool {
+	var appErr *AppError
+	return errors.As(err, &appErr) && appErr.Code == code
+}
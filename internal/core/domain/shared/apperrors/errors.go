@@ -11,6 +11,7 @@ const (
 	ErrCodeValidation   = "VALIDATION_ERROR"
 	ErrCodeUnauthorized = "UNAUTHORIZED"
 	ErrCodeForbidden    = "FORBIDDEN"
+	ErrCodeConflict     = "CONFLICT"
 	ErrCodeInternal     = "INTERNAL_ERROR"
 )
 
@@ -24,6 +25,8 @@ func MapToHttpCode(code string) int {
 		return http.StatusUnauthorized
 	case ErrCodeForbidden:
 		return http.StatusForbidden
+	case ErrCodeConflict:
+		return http.StatusConflict
 	case ErrCodeInternal:
 		return http.StatusInternalServerError
 	default:
@@ -41,6 +44,8 @@ func MapToGrpcStatus(code string) codes.Code {
 		return codes.Unauthenticated
 	case ErrCodeForbidden:
 		return codes.PermissionDenied
+	case ErrCodeConflict:
+		return codes.FailedPrecondition
 	default:
 		return codes.Internal
 	}
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+const (
+	MethodJWT          = "jwt"
+	MethodAPIKey       = "api_key"
+	MethodLegacyHeader = "legacy_header"
+)
+
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	UserID  uint
+	Subject string
+	Method  string // how the caller was authenticated
+	Scopes  []string
+}
+
+// Allows reports whether the principal may perform an action that needs scope.
+// Only API keys are limited by their scopes, a key with write access may also read.
+func (p *Principal) Allows(scope string) bool {
+	if p.Method != MethodAPIKey {
+		return true
+	}
+	if scope == ScopeRead && slices.Contains(p.Scopes, ScopeWrite) {
+		return true
+	}
+	return slices.Contains(p.Scopes, scope)
+}
+
+type principalKey struct{}
+
+// NewContext returns a copy of ctx carrying the principal.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal carried by ctx, if any.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
+
+// UserID returns the id of the user ctx was authenticated as, unauthorized without a principal.
+func UserID(ctx context.Context) (uint, error) {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return 0, apperrors.New(apperrors.ErrCodeUnauthorized, "request is not authenticated", nil)
+	}
+	return p.UserID, nil
+}
@@ -0,0 +1,19 @@
+package ordering
+
+import "math/rand/v2"
+
+// JitteredKeyBetween returns a key between a and b like KeyBetween, picked at random among
+// spread evenly spaced candidates. Two writers filling the same gap at the same time then
+// only collide once in spread attempts instead of every time.
+func JitteredKeyBetween(a, b string, spread uint) (string, error) {
+	if spread < 2 {
+		return KeyBetween(a, b)
+	}
+
+	keys, err := NKeysBetween(a, b, spread)
+	if err != nil {
+		return "", err
+	}
+
+	return keys[rand.IntN(len(keys))], nil
+}
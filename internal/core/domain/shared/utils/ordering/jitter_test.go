@@ -0,0 +1,115 @@
+package ordering
+
+import (
+	"testing"
+)
+
+func TestNKeysBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		n    uint
+	}{
+		{name: "empty list", n: 5},
+		{name: "before the first key", b: "a0", n: 3},
+		{name: "after the last key", a: "a0", n: 3},
+		{name: "between neighbours", a: "a0", b: "a1", n: 10},
+		{name: "between close keys", a: "a0V", b: "a0W", n: 4},
+		{name: "single key", a: "a0", b: "a5", n: 1},
+		{name: "no keys", a: "a0", b: "a5", n: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := NKeysBetween(tt.a, tt.b, tt.n)
+			if err != nil {
+				t.Fatalf("failed to generate keys: %v", err)
+			}
+			if uint(len(keys)) != tt.n {
+				t.Fatalf("got %d keys, want %d", len(keys), tt.n)
+			}
+
+			prev := tt.a
+			for _, key := range keys {
+				assertBetween(t, key, prev, tt.b)
+				prev = key
+			}
+		})
+	}
+}
+
+func TestNKeysBetweenInvalidBounds(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+	}{
+		{name: "equal bounds", a: "a1", b: "a1"},
+		{name: "reversed bounds", a: "a2", b: "a1"},
+		{name: "invalid key", a: "a1", b: "!!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if keys, err := NKeysBetween(tt.a, tt.b, 2); err == nil {
+				t.Fatalf("got keys %v, want an error", keys)
+			}
+		})
+	}
+}
+
+func TestJitteredKeyBetween(t *testing.T) {
+	const draws = 200
+
+	tests := []struct {
+		name     string
+		a, b     string
+		spread   uint
+		distinct bool
+	}{
+		{name: "no spread falls back to the midpoint", a: "a0", b: "a1", spread: 1},
+		{name: "between neighbours", a: "a0", b: "a1", spread: 8, distinct: true},
+		{name: "at the top", b: "a0", spread: 8, distinct: true},
+		{name: "at the bottom", a: "a0", spread: 8, distinct: true},
+		{name: "empty list", spread: 8, distinct: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := make(map[string]bool)
+			for range draws {
+				key, err := JitteredKeyBetween(tt.a, tt.b, tt.spread)
+				if err != nil {
+					t.Fatalf("failed to generate key: %v", err)
+				}
+				assertBetween(t, key, tt.a, tt.b)
+				seen[key] = true
+			}
+
+			if uint(len(seen)) > max(tt.spread, 1) {
+				t.Fatalf("drew %d distinct keys out of a spread of %d", len(seen), tt.spread)
+			}
+			// concurrent writers only avoid each other when the keys really vary
+			if tt.distinct && len(seen) < 2 {
+				t.Fatalf("drew the same key %d times", draws)
+			}
+			if !tt.distinct && len(seen) != 1 {
+				t.Fatalf("drew %d distinct keys, want the midpoint only", len(seen))
+			}
+		})
+	}
+}
+
+// assertBetween checks key is valid and sorts strictly between a and b, empty bounds are open.
+func assertBetween(t *testing.T, key, a, b string) {
+	t.Helper()
+
+	if err := validateOrderKey(key); err != nil {
+		t.Fatalf("key %q is invalid: %v", key, err)
+	}
+	if a != "" && key <= a {
+		t.Fatalf("key %q does not follow %q", key, a)
+	}
+	if b != "" && key >= b {
+		t.Fatalf("key %q does not precede %q", key, b)
+	}
+}
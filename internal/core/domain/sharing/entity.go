@@ -0,0 +1,68 @@
+package sharing
+
+import "time"
+
+const (
+	RoleOwner  string = "owner"
+	RoleEditor string = "editor"
+	RoleViewer string = "viewer"
+)
+
+const (
+	SharePending  string = "pending"
+	ShareAccepted string = "accepted"
+	ShareRevoked  string = "revoked"
+)
+
+// Action is what a caller wants to do with a list or one of its products.
+type Action string
+
+const (
+	// ActionView reads products, their causes and history
+	ActionView Action = "view"
+	// ActionEdit changes, moves, adds and deletes products
+	ActionEdit Action = "edit"
+	// ActionManage shares the list with others
+	ActionManage Action = "manage"
+)
+
+// TODO: when logic is complex, should not return domain object directly
+type Share struct {
+	ID        uint   `json:"id"`
+	ListID    uint   `json:"listId"`
+	OwnerID   uint   `json:"ownerId"`
+	UserID    uint   `json:"userId"`
+	Role      string `json:"role"`
+	Status    string `json:"status"`
+	InvitedBy uint   `json:"invitedBy"`
+
+	AcceptedAt *time.Time `json:"acceptedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+// IsValidRole reports whether role can be given to an invited user, owner is implied.
+func IsValidRole(role string) bool {
+	return role == RoleEditor || role == RoleViewer
+}
+
+// Allows reports whether role permits action. Owners may do anything, editors everything
+// but sharing, viewers only look.
+func Allows(role string, action Action) bool {
+	switch role {
+	case RoleOwner:
+		return true
+	case RoleEditor:
+		return action == ActionView || action == ActionEdit
+	case RoleViewer:
+		return action == ActionView
+	default:
+		return false
+	}
+}
+
+// IsActive reports whether the share still grants or may still grant access.
+func (s *Share) IsActive() bool {
+	return s.Status == SharePending || s.Status == ShareAccepted
+}
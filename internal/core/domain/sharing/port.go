@@ -0,0 +1,45 @@
+package sharing
+
+import (
+	"context"
+	"time"
+)
+
+// AccessPolicy decides what a user may do with the lists and products of others. Both
+// checks return the owner id the resources are stored under, so callers keep using owner
+// scoped repositories. Resources the user can not see are reported as not found, visible
+// ones the role does not allow changing as forbidden.
+type AccessPolicy interface {
+	AuthorizeList(ctx context.Context, userID uint, listID uint, action Action) (uint, error)
+	AuthorizeProduct(ctx context.Context, userID uint, productID uint, action Action) (uint, error)
+}
+
+type SharingUsecase interface {
+	AccessPolicy
+
+	// Invite shares the list with another user, who has to accept before gaining access.
+	Invite(ctx context.Context, ownerID uint, listID uint, userID uint, role string) (*Share, error)
+	// ListShares returns the pending and accepted shares of a list, only to its owner.
+	ListShares(ctx context.Context, ownerID uint, listID uint) ([]*Share, error)
+	// ListInvitations returns the shares offered to the user in the status, active ones when empty.
+	ListInvitations(ctx context.Context, userID uint, status string) ([]*Share, error)
+	Accept(ctx context.Context, userID uint, shareID uint) (*Share, error)
+	// Revoke ends a share, the list owner withdraws it or the invited user declines or leaves.
+	Revoke(ctx context.Context, userID uint, shareID uint) (*Share, error)
+}
+
+type SharingRepository interface {
+	CreateShare(ctx context.Context, share *Share) (*Share, error)
+	GetShare(ctx context.Context, shareID uint) (*Share, error)
+	FindByList(ctx context.Context, listID uint) ([]*Share, error)
+	FindByUser(ctx context.Context, userID uint, statuses []string) ([]*Share, error)
+	// FindRole returns the role of an accepted share of the list, "" when there is none.
+	FindRole(ctx context.Context, listID uint, userID uint) (string, error)
+	AcceptShare(ctx context.Context, shareID uint, acceptedAt time.Time) error
+	RevokeShare(ctx context.Context, shareID uint, revokedAt time.Time) error
+
+	// GetListOwner returns the owner of the list, not found when there is no such list.
+	GetListOwner(ctx context.Context, listID uint) (uint, error)
+	// GetProductPlacement returns the owner and list of the product, not found when there is no such product.
+	GetProductPlacement(ctx context.Context, productID uint) (uint, uint, error)
+}
@@ -0,0 +1,233 @@
+package sharing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"github.com/zhunismp/intent-products-api/internal/core/infrastructure/transaction"
+)
+
+type sharingService struct {
+	sharingRepo SharingRepository
+	txManager   transaction.TxManager
+	logger      *slog.Logger
+}
+
+func NewSharingService(sharingRepo SharingRepository, txManager transaction.TxManager, logger *slog.Logger) SharingUsecase {
+	return &sharingService{
+		sharingRepo: sharingRepo,
+		txManager:   txManager,
+		logger:      logger,
+	}
+}
+
+func (s *sharingService) AuthorizeList(ctx context.Context, userID, listID uint, action Action) (uint, error) {
+	ownerID, err := s.sharingRepo.GetListOwner(ctx, listID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.authorize(ctx, userID, ownerID, listID, action); err != nil {
+		return 0, err
+	}
+	return ownerID, nil
+}
+
+func (s *sharingService) AuthorizeProduct(ctx context.Context, userID, productID uint, action Action) (uint, error) {
+	ownerID, listID, err := s.sharingRepo.GetProductPlacement(ctx, productID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.authorize(ctx, userID, ownerID, listID, action); err != nil {
+		if apperrors.IsCode(err, apperrors.ErrCodeNotFound) {
+			return 0, apperrors.New(
+				apperrors.ErrCodeNotFound,
+				fmt.Sprintf("product id %d not found for owner id %d", productID, userID),
+				nil,
+			)
+		}
+		return 0, err
+	}
+	return ownerID, nil
+}
+
+// authorize checks the role the user holds on the list of ownerID permits action. Lists the
+// user holds no accepted share of stay hidden behind not found.
+func (s *sharingService) authorize(ctx context.Context, userID, ownerID, listID uint, action Action) error {
+	role := RoleOwner
+	if ownerID != userID {
+		r, err := s.sharingRepo.FindRole(ctx, listID, userID)
+		if err != nil {
+			return err
+		}
+		if r == "" {
+			return apperrors.New(
+				apperrors.ErrCodeNotFound,
+				fmt.Sprintf("wishlist id %d not found for owner id %d", listID, userID),
+				nil,
+			)
+		}
+		role = r
+	}
+
+	if !Allows(role, action) {
+		return apperrors.New(
+			apperrors.ErrCodeForbidden,
+			fmt.Sprintf("a %s of wishlist id %d can not %s it", role, listID, action),
+			nil,
+		)
+	}
+	return nil
+}
+
+func (s *sharingService) Invite(ctx context.Context, ownerID, listID, userID uint, role string) (*Share, error) {
+	if !IsValidRole(role) {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "role must be viewer or editor", nil)
+	}
+	if userID == 0 || userID == ownerID {
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "a wishlist can only be shared with another user", nil)
+	}
+
+	if _, err := s.AuthorizeList(ctx, ownerID, listID, ActionManage); err != nil {
+		return nil, err
+	}
+
+	// the partial unique index keeps a single active share per user and list
+	share, err := s.sharingRepo.CreateShare(ctx, &Share{
+		ListID:    listID,
+		OwnerID:   ownerID,
+		UserID:    userID,
+		Role:      role,
+		Status:    SharePending,
+		InvitedBy: ownerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "invited user to wishlist successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Group("share_info",
+			slog.Uint64("id", uint64(share.ID)),
+			slog.Uint64("wishlist_id", uint64(listID)),
+			slog.Uint64("invitee_id", uint64(userID)),
+			slog.String("role", role),
+		),
+	)
+
+	return share, nil
+}
+
+func (s *sharingService) ListShares(ctx context.Context, ownerID, listID uint) ([]*Share, error) {
+	if _, err := s.AuthorizeList(ctx, ownerID, listID, ActionManage); err != nil {
+		return nil, err
+	}
+
+	return s.sharingRepo.FindByList(ctx, listID)
+}
+
+func (s *sharingService) ListInvitations(ctx context.Context, userID uint, status string) ([]*Share, error) {
+	statuses := []string{SharePending, ShareAccepted}
+	switch status {
+	case "":
+	case SharePending, ShareAccepted, ShareRevoked:
+		statuses = []string{status}
+	default:
+		return nil, apperrors.New(apperrors.ErrCodeValidation, "status must be pending, accepted or revoked", nil)
+	}
+
+	return s.sharingRepo.FindByUser(ctx, userID, statuses)
+}
+
+func (s *sharingService) Accept(ctx context.Context, userID, shareID uint) (*Share, error) {
+	var share *Share
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		sh, err := s.getInvitation(ctx, userID, shareID)
+		if err != nil {
+			return err
+		}
+		if sh.UserID != userID {
+			return apperrors.New(apperrors.ErrCodeForbidden, "only the invited user can accept an invitation", nil)
+		}
+		if sh.Status != SharePending {
+			return apperrors.New(
+				apperrors.ErrCodeConflict,
+				fmt.Sprintf("invitation id %d is already %s", shareID, sh.Status),
+				nil,
+			)
+		}
+
+		if err := s.sharingRepo.AcceptShare(ctx, shareID, time.Now()); err != nil {
+			return err
+		}
+
+		share, err = s.sharingRepo.GetShare(ctx, shareID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "accepted wishlist invitation successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Uint64("share_id", uint64(shareID)),
+		slog.Uint64("wishlist_id", uint64(share.ListID)),
+	)
+
+	return share, nil
+}
+
+func (s *sharingService) Revoke(ctx context.Context, userID, shareID uint) (*Share, error) {
+	var share *Share
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		sh, err := s.getInvitation(ctx, userID, shareID)
+		if err != nil {
+			return err
+		}
+		if !sh.IsActive() {
+			return apperrors.New(
+				apperrors.ErrCodeConflict,
+				fmt.Sprintf("invitation id %d is already %s", shareID, sh.Status),
+				nil,
+			)
+		}
+
+		if err := s.sharingRepo.RevokeShare(ctx, shareID, time.Now()); err != nil {
+			return err
+		}
+
+		share, err = s.sharingRepo.GetShare(ctx, shareID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "revoked wishlist share successfully",
+		slog.Uint64("user_id", uint64(userID)),
+		slog.Uint64("share_id", uint64(shareID)),
+		slog.Uint64("wishlist_id", uint64(share.ListID)),
+	)
+
+	return share, nil
+}
+
+// getInvitation returns the share when the user is on either side of it, not found otherwise.
+func (s *sharingService) getInvitation(ctx context.Context, userID, shareID uint) (*Share, error) {
+	share, err := s.sharingRepo.GetShare(ctx, shareID)
+	if err != nil {
+		return nil, err
+	}
+	if share.OwnerID != userID && share.UserID != userID {
+		return nil, apperrors.New(
+			apperrors.ErrCodeNotFound,
+			fmt.Sprintf("invitation id %d not found for user id %d", shareID, userID),
+			nil,
+		)
+	}
+	return share, nil
+}
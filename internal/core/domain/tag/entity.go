@@ -0,0 +1,30 @@
+package tag
+
+import (
+	"strings"
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+const MaxTagNameLength = 50
+
+// TODO: when logic is complex, should not return domain object directly
+type Tag struct {
+	ID      uint   `json:"id"`
+	OwnerID uint   `json:"ownerId"`
+	Name    string `json:"name"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// NormalizeTagName trims the name and checks its length. Names are compared ignoring case,
+// the spelling given first is kept.
+func NormalizeTagName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > MaxTagNameLength {
+		return "", apperrors.New(apperrors.ErrCodeValidation, "tag name must be 1 to 50 characters", nil)
+	}
+	return name, nil
+}
@@ -0,0 +1,35 @@
+package tag
+
+import "context"
+
+type TagUsecase interface {
+	CreateTag(ctx context.Context, ownerID uint, name string) (*Tag, error)
+	GetTag(ctx context.Context, ownerID uint, tagID uint) (*Tag, error)
+	ListTags(ctx context.Context, ownerID uint) ([]*Tag, error)
+	RenameTag(ctx context.Context, ownerID uint, tagID uint, name string) (*Tag, error)
+	// DeleteTag removes the tag from every product carrying it.
+	DeleteTag(ctx context.Context, ownerID uint, tagID uint) error
+
+	GetProductTags(ctx context.Context, productID uint) ([]*Tag, error)
+	// TagProduct links the tags of the owner to the product, tags already linked are kept.
+	TagProduct(ctx context.Context, ownerID uint, productID uint, tagIDs []uint) error
+	UntagProduct(ctx context.Context, productID uint, tagID uint) error
+	// ClearProductTags unlinks every tag of the product.
+	ClearProductTags(ctx context.Context, productID uint) error
+}
+
+type TagRepository interface {
+	CreateTag(ctx context.Context, tag *Tag) (*Tag, error)
+	GetTag(ctx context.Context, ownerID uint, tagID uint) (*Tag, error)
+	FindByOwner(ctx context.Context, ownerID uint) ([]*Tag, error)
+	// CountOwned returns how many of tagIDs belong to the owner.
+	CountOwned(ctx context.Context, ownerID uint, tagIDs []uint) (int64, error)
+	UpdateTag(ctx context.Context, tag *Tag) (*Tag, error)
+	DeleteTag(ctx context.Context, ownerID uint, tagID uint) error
+
+	FindByProductID(ctx context.Context, productID uint) ([]*Tag, error)
+	LinkProduct(ctx context.Context, productID uint, tagIDs []uint) error
+	UnlinkProduct(ctx context.Context, productID uint, tagID uint) error
+	UnlinkAllOfProduct(ctx context.Context, productID uint) error
+	UnlinkAllOfTag(ctx context.Context, tagID uint) error
+}
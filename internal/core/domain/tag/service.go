@@ -0,0 +1,140 @@
+package tag
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"github.com/zhunismp/intent-products-api/internal/core/infrastructure/transaction"
+)
+
+type tagService struct {
+	tagRepo   TagRepository
+	txManager transaction.TxManager
+	logger    *slog.Logger
+}
+
+func NewTagService(tagRepo TagRepository, txManager transaction.TxManager, logger *slog.Logger) TagUsecase {
+	return &tagService{
+		tagRepo:   tagRepo,
+		txManager: txManager,
+		logger:    logger,
+	}
+}
+
+func (s *tagService) CreateTag(ctx context.Context, ownerID uint, name string) (*Tag, error) {
+	name, err := NormalizeTagName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// the unique index on the lowered name reports a taken name as a conflict
+	tag, err := s.tagRepo.CreateTag(ctx, &Tag{OwnerID: ownerID, Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "created tag successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Group("tag_info",
+			slog.Uint64("id", uint64(tag.ID)),
+			slog.String("name", tag.Name),
+		),
+	)
+
+	return tag, nil
+}
+
+func (s *tagService) GetTag(ctx context.Context, ownerID, tagID uint) (*Tag, error) {
+	return s.tagRepo.GetTag(ctx, ownerID, tagID)
+}
+
+func (s *tagService) ListTags(ctx context.Context, ownerID uint) ([]*Tag, error) {
+	tags, err := s.tagRepo.FindByOwner(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "get tags successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Int("tag_count", len(tags)),
+	)
+
+	return tags, nil
+}
+
+func (s *tagService) RenameTag(ctx context.Context, ownerID, tagID uint, name string) (*Tag, error) {
+	name, err := NormalizeTagName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.tagRepo.UpdateTag(ctx, &Tag{ID: tagID, OwnerID: ownerID, Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "renamed tag successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Group("tag_info",
+			slog.Uint64("id", uint64(tag.ID)),
+			slog.String("name", tag.Name),
+		),
+	)
+
+	return tag, nil
+}
+
+func (s *tagService) DeleteTag(ctx context.Context, ownerID, tagID uint) error {
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.tagRepo.DeleteTag(ctx, ownerID, tagID); err != nil {
+			return err
+		}
+
+		return s.tagRepo.UnlinkAllOfTag(ctx, tagID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "deleted tag successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Uint64("tag_id", uint64(tagID)),
+	)
+
+	return nil
+}
+
+func (s *tagService) GetProductTags(ctx context.Context, productID uint) ([]*Tag, error) {
+	return s.tagRepo.FindByProductID(ctx, productID)
+}
+
+func (s *tagService) TagProduct(ctx context.Context, ownerID, productID uint, tagIDs []uint) error {
+	if len(tagIDs) == 0 {
+		return apperrors.New(apperrors.ErrCodeValidation, "at least one tag id is required", nil)
+	}
+
+	ids := slices.Clone(tagIDs)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	// tags of other owners are never linked, not even to a product shared with them
+	owned, err := s.tagRepo.CountOwned(ctx, ownerID, ids)
+	if err != nil {
+		return err
+	}
+	if owned != int64(len(ids)) {
+		return apperrors.New(apperrors.ErrCodeNotFound, "some tags were not found for the product owner", nil)
+	}
+
+	return s.tagRepo.LinkProduct(ctx, productID, ids)
+}
+
+func (s *tagService) UntagProduct(ctx context.Context, productID, tagID uint) error {
+	return s.tagRepo.UnlinkProduct(ctx, productID, tagID)
+}
+
+func (s *tagService) ClearProductTags(ctx context.Context, productID uint) error {
+	return s.tagRepo.UnlinkAllOfProduct(ctx, productID)
+}
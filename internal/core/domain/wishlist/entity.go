@@ -0,0 +1,92 @@
+package wishlist
+
+import (
+	"time"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+)
+
+// DefaultWishlistName names the list every owner gets on first use, it holds the products
+// created without a list.
+const DefaultWishlistName = "My wishlist"
+
+const (
+	MaxWishlistNameLength  = 100
+	MaxWishlistEmojiLength = 16
+)
+
+// TODO: when logic is complex, should not return domain object directly
+type Wishlist struct {
+	ID        uint   `json:"id"`
+	OwnerID   uint   `json:"ownerId"`
+	Name      string `json:"name"`
+	Emoji     string `json:"emoji"`
+	Color     string `json:"color"` // #rrggbb, empty for the client default
+	Archived  bool   `json:"archived"`
+	IsDefault bool   `json:"isDefault"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WishlistPatch describes a partial update of a wishlist. Nil fields are left untouched.
+type WishlistPatch struct {
+	Name     *string
+	Emoji    *string
+	Color    *string
+	Archived *bool
+}
+
+func (p *WishlistPatch) IsEmpty() bool {
+	return p == nil ||
+		p.Name == nil &&
+			p.Emoji == nil &&
+			p.Color == nil &&
+			p.Archived == nil
+}
+
+func (p *WishlistPatch) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.Name != nil && (*p.Name == "" || len(*p.Name) > MaxWishlistNameLength) {
+		return apperrors.New(apperrors.ErrCodeValidation, "name must be 1 to 100 characters", nil)
+	}
+	if p.Emoji != nil && len(*p.Emoji) > MaxWishlistEmojiLength {
+		return apperrors.New(apperrors.ErrCodeValidation, "emoji must be at most 16 bytes", nil)
+	}
+	if p.Color != nil && *p.Color != "" && !IsValidColor(*p.Color) {
+		return apperrors.New(apperrors.ErrCodeValidation, "color must be a #rrggbb hex color", nil)
+	}
+	return nil
+}
+
+func (p *WishlistPatch) ApplyTo(list *Wishlist) {
+	if p.Name != nil {
+		list.Name = *p.Name
+	}
+	if p.Emoji != nil {
+		list.Emoji = *p.Emoji
+	}
+	if p.Color != nil {
+		list.Color = *p.Color
+	}
+	if p.Archived != nil {
+		list.Archived = *p.Archived
+	}
+}
+
+// IsValidColor reports whether color is a #rrggbb hex color.
+func IsValidColor(color string) bool {
+	if len(color) != 7 || color[0] != '#' {
+		return false
+	}
+	for _, c := range color[1:] {
+		isDigit := c >= '0' && c <= '9'
+		isHex := c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+		if !isDigit && !isHex {
+			return false
+		}
+	}
+	return true
+}
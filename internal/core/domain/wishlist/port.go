@@ -0,0 +1,30 @@
+package wishlist
+
+import (
+	"context"
+)
+
+type WishlistUsecase interface {
+	CreateWishlist(ctx context.Context, ownerID uint, name string, emoji string, color string) (*Wishlist, error)
+	GetWishlist(ctx context.Context, ownerID uint, listID uint) (*Wishlist, error)
+	// ListWishlists returns the owner's lists, the default one first, archived ones only when asked.
+	ListWishlists(ctx context.Context, ownerID uint, includeArchived bool) ([]*Wishlist, error)
+	UpdateWishlist(ctx context.Context, ownerID uint, listID uint, patch *WishlistPatch) (*Wishlist, error)
+	// DeleteWishlist removes an empty list, the default list can not be deleted.
+	DeleteWishlist(ctx context.Context, ownerID uint, listID uint) error
+
+	// ResolveList returns the list products are written to, the owner's default list when
+	// listID is 0. Archived lists are refused.
+	ResolveList(ctx context.Context, ownerID uint, listID uint) (*Wishlist, error)
+}
+
+type WishlistRepository interface {
+	CreateWishlist(ctx context.Context, list *Wishlist) (*Wishlist, error)
+	GetWishlist(ctx context.Context, ownerID uint, listID uint) (*Wishlist, error)
+	// EnsureDefault returns the owner's default list, creating it when there is none yet.
+	EnsureDefault(ctx context.Context, ownerID uint) (*Wishlist, error)
+	FindByOwner(ctx context.Context, ownerID uint, includeArchived bool) ([]*Wishlist, error)
+	UpdateWishlist(ctx context.Context, list *Wishlist) (*Wishlist, error)
+	CountProducts(ctx context.Context, listID uint) (int64, error)
+	DeleteWishlist(ctx context.Context, ownerID uint, listID uint) error
+}
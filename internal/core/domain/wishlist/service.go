@@ -0,0 +1,184 @@
+package wishlist
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/apperrors"
+	"github.com/zhunismp/intent-products-api/internal/core/infrastructure/transaction"
+)
+
+type wishlistService struct {
+	wishlistRepo WishlistRepository
+	txManager    transaction.TxManager
+	logger       *slog.Logger
+}
+
+func NewWishlistService(wishlistRepo WishlistRepository, txManager transaction.TxManager, logger *slog.Logger) WishlistUsecase {
+	return &wishlistService{
+		wishlistRepo: wishlistRepo,
+		txManager:    txManager,
+		logger:       logger,
+	}
+}
+
+func (s *wishlistService) CreateWishlist(ctx context.Context, ownerID uint, name, emoji, color string) (*Wishlist, error) {
+	patch := &WishlistPatch{Name: &name, Emoji: &emoji, Color: &color}
+	if err := patch.Validate(); err != nil {
+		return nil, err
+	}
+
+	var list *Wishlist
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		// the default list claims its name before the owner can pick it for another list
+		if _, err := s.wishlistRepo.EnsureDefault(ctx, ownerID); err != nil {
+			return err
+		}
+
+		l, err := s.wishlistRepo.CreateWishlist(ctx, &Wishlist{
+			OwnerID: ownerID,
+			Name:    name,
+			Emoji:   emoji,
+			Color:   color,
+		})
+		list = l
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "created wishlist successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Group("wishlist_info",
+			slog.Uint64("id", uint64(list.ID)),
+			slog.String("name", list.Name),
+		),
+	)
+
+	return list, nil
+}
+
+func (s *wishlistService) GetWishlist(ctx context.Context, ownerID, listID uint) (*Wishlist, error) {
+	return s.wishlistRepo.GetWishlist(ctx, ownerID, listID)
+}
+
+func (s *wishlistService) ListWishlists(ctx context.Context, ownerID uint, includeArchived bool) ([]*Wishlist, error) {
+	if _, err := s.wishlistRepo.EnsureDefault(ctx, ownerID); err != nil {
+		return nil, err
+	}
+
+	lists, err := s.wishlistRepo.FindByOwner(ctx, ownerID, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "get wishlists successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Int("wishlist_count", len(lists)),
+	)
+
+	return lists, nil
+}
+
+func (s *wishlistService) UpdateWishlist(ctx context.Context, ownerID, listID uint, patch *WishlistPatch) (*Wishlist, error) {
+	if err := patch.Validate(); err != nil {
+		return nil, err
+	}
+
+	var list *Wishlist
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		l, err := s.wishlistRepo.GetWishlist(ctx, ownerID, listID)
+		if err != nil {
+			return err
+		}
+
+		if l.IsDefault && patch.Archived != nil && *patch.Archived {
+			return apperrors.New(apperrors.ErrCodeConflict, "the default wishlist can not be archived", nil)
+		}
+
+		if !patch.IsEmpty() {
+			patch.ApplyTo(l)
+
+			l, err = s.wishlistRepo.UpdateWishlist(ctx, l)
+			if err != nil {
+				return err
+			}
+		}
+
+		list = l
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "updated wishlist successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Group("wishlist_info",
+			slog.Uint64("id", uint64(list.ID)),
+			slog.String("name", list.Name),
+			slog.Bool("archived", list.Archived),
+		),
+	)
+
+	return list, nil
+}
+
+func (s *wishlistService) DeleteWishlist(ctx context.Context, ownerID, listID uint) error {
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		list, err := s.wishlistRepo.GetWishlist(ctx, ownerID, listID)
+		if err != nil {
+			return err
+		}
+		if list.IsDefault {
+			return apperrors.New(apperrors.ErrCodeConflict, "the default wishlist can not be deleted", nil)
+		}
+
+		// products are never dropped along with their list, they have to be moved or deleted first
+		count, err := s.wishlistRepo.CountProducts(ctx, listID)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return apperrors.New(
+				apperrors.ErrCodeConflict,
+				fmt.Sprintf("wishlist id %d still has %d products, move or delete them first", listID, count),
+				nil,
+			)
+		}
+
+		return s.wishlistRepo.DeleteWishlist(ctx, ownerID, listID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "deleted wishlist successfully",
+		slog.Uint64("user_id", uint64(ownerID)),
+		slog.Uint64("wishlist_id", uint64(listID)),
+	)
+
+	return nil
+}
+
+func (s *wishlistService) ResolveList(ctx context.Context, ownerID, listID uint) (*Wishlist, error) {
+	if listID == 0 {
+		return s.wishlistRepo.EnsureDefault(ctx, ownerID)
+	}
+
+	list, err := s.wishlistRepo.GetWishlist(ctx, ownerID, listID)
+	if err != nil {
+		return nil, err
+	}
+	if list.Archived {
+		return nil, apperrors.New(
+			apperrors.ErrCodeConflict,
+			fmt.Sprintf("wishlist id %d is archived, unarchive it to add products", listID),
+			nil,
+		)
+	}
+
+	return list, nil
+}
@@ -10,6 +10,14 @@ type ServerConfigProvider interface {
 
 	// grpc config
 	GetGrpcServerPort() string
+
+	// openapi config
+	GetOpenAPIValidationEnabled() bool
+
+	// rest gateway config
+	GetGatewayEnabled() bool
+	GetGatewayPort() string
+	GetGatewayGrpcTarget() string
 }
 
 type DatabaseConfigProvider interface {
@@ -22,7 +30,21 @@ type DatabaseConfigProvider interface {
 	GetDBTimezone() string
 }
 
+type AuthConfigProvider interface {
+	GetAuthJwksFile() string
+	GetAuthJwksURL() string
+	GetAuthIssuer() string
+	GetAuthAudience() string
+	GetAuthLegacyHeaderEnabled() bool
+}
+
+type ProductConfigProvider interface {
+	GetPositionMaxKeyLength() int
+}
+
 type AppConfigProvider interface {
 	ServerConfigProvider
 	DatabaseConfigProvider
+	AuthConfigProvider
+	ProductConfigProvider
 }
@@ -0,0 +1,12 @@
+package token
+
+import (
+	"context"
+
+	"github.com/zhunismp/intent-products-api/internal/core/domain/shared/auth"
+)
+
+// Verifier checks a bearer token and resolves the caller it was issued to.
+type Verifier interface {
+	Verify(ctx context.Context, token string) (*auth.Principal, error)
+}
@@ -0,0 +1,11 @@
+package transaction
+
+import "context"
+
+// TxManager runs a unit of work inside a single transaction.
+type TxManager interface {
+	// WithinTx runs fn in a transaction that is carried by the context given to fn.
+	// Repositories called with that context join the transaction. A call nested in
+	// an existing transaction joins it instead of starting a new one.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}